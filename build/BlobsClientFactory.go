@@ -18,10 +18,16 @@ func NewBlobsClientFactory() *BlobsClientFactory {
 	mockClientDescriptor := cref.NewDescriptor("service-blobs", "client", "mock", "*", "1.0")
 	cmdHttpClientDescriptor := cref.NewDescriptor("service-blobs", "client", "commandable-http", "*", "1.0")
 	cmdGrpcClientDescriptor := cref.NewDescriptor("service-blobs", "client", "commandable-grpc", "*", "1.0")
+	grpcClientDescriptor := cref.NewDescriptor("service-blobs", "client", "grpc", "*", "1.0")
+	autoClientDescriptor := cref.NewDescriptor("service-blobs", "client", "auto", "*", "1.0")
+	fileClientDescriptor := cref.NewDescriptor("service-blobs", "client", "file", "*", "1.0")
 
 	c.RegisterType(nullClientDescriptor, version1.NewBlobsNullClientV1)
 	c.RegisterType(mockClientDescriptor, version1.NewBlobsMockClientV1)
 	c.RegisterType(cmdHttpClientDescriptor, version1.NewBlobsCommandableHttpClientV1)
 	c.RegisterType(cmdGrpcClientDescriptor, version1.NewBlobsCommandableGrpcClientV1)
+	c.RegisterType(grpcClientDescriptor, version1.NewBlobGrpcClientV1)
+	c.RegisterType(autoClientDescriptor, version1.NewBlobsAutoClientV1)
+	c.RegisterType(fileClientDescriptor, version1.NewBlobsFileClientV1)
 	return &c
 }
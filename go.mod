@@ -3,7 +3,9 @@ module github.com/pip-services-infrastructure2/client-blobs-go
 go 1.18
 
 require (
+	github.com/klauspost/compress v1.15.15
 	github.com/pip-services3-gox/pip-services3-commons-gox v1.0.8
+	github.com/pip-services3-gox/pip-services3-components-gox v1.0.7
 	github.com/pip-services3-gox/pip-services3-grpc-gox v1.0.2
 	github.com/pip-services3-gox/pip-services3-rpc-gox v1.0.6
 	github.com/stretchr/testify v1.8.1
@@ -18,7 +20,6 @@ require (
 	github.com/google/uuid v1.3.0 // indirect
 	github.com/gorilla/handlers v1.5.1 // indirect
 	github.com/gorilla/mux v1.8.0 // indirect
-	github.com/pip-services3-gox/pip-services3-components-gox v1.0.7 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	golang.org/x/net v0.0.0-20220722155237-a158d28d115b // indirect
 	golang.org/x/sys v0.0.0-20220722155257-8c9f86f7a55f // indirect
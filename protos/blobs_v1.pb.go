@@ -208,6 +208,61 @@ func (x *PagingParams) GetTotal() bool {
 	return false
 }
 
+type SortField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ascending bool   `protobuf:"varint,2,opt,name=ascending,proto3" json:"ascending,omitempty"`
+}
+
+func (x *SortField) Reset() {
+	*x = SortField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SortField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SortField) ProtoMessage() {}
+
+func (x *SortField) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SortField.ProtoReflect.Descriptor instead.
+func (*SortField) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SortField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SortField) GetAscending() bool {
+	if x != nil {
+		return x.Ascending
+	}
+	return false
+}
+
 type BlobInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -223,12 +278,20 @@ type BlobInfo struct {
 	CreateTime  string `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
 	ExpireTime  string `protobuf:"bytes,7,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
 	Completed   bool   `protobuf:"varint,8,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Integrity
+	ChecksumAlgorithm string `protobuf:"bytes,9,opt,name=checksum_algorithm,json=checksumAlgorithm,proto3" json:"checksum_algorithm,omitempty"`
+	Checksum          string `protobuf:"bytes,10,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Custom data
+	Metadata map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Tags     []string          `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Encoding
+	ContentEncoding string `protobuf:"bytes,13,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
 func (x *BlobInfo) Reset() {
 	*x = BlobInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[2]
+		mi := &file_protos_blobs_v1_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -241,7 +304,7 @@ func (x *BlobInfo) String() string {
 func (*BlobInfo) ProtoMessage() {}
 
 func (x *BlobInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[2]
+	mi := &file_protos_blobs_v1_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -254,7 +317,7 @@ func (x *BlobInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfo.ProtoReflect.Descriptor instead.
 func (*BlobInfo) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{2}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *BlobInfo) GetId() string {
@@ -313,6 +376,41 @@ func (x *BlobInfo) GetCompleted() bool {
 	return false
 }
 
+func (x *BlobInfo) GetChecksumAlgorithm() string {
+	if x != nil {
+		return x.ChecksumAlgorithm
+	}
+	return ""
+}
+
+func (x *BlobInfo) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *BlobInfo) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *BlobInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *BlobInfo) GetContentEncoding() string {
+	if x != nil {
+		return x.ContentEncoding
+	}
+	return ""
+}
+
 type BlobInfoPage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -325,7 +423,7 @@ type BlobInfoPage struct {
 func (x *BlobInfoPage) Reset() {
 	*x = BlobInfoPage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[3]
+		mi := &file_protos_blobs_v1_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -338,7 +436,7 @@ func (x *BlobInfoPage) String() string {
 func (*BlobInfoPage) ProtoMessage() {}
 
 func (x *BlobInfoPage) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[3]
+	mi := &file_protos_blobs_v1_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -351,7 +449,7 @@ func (x *BlobInfoPage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoPage.ProtoReflect.Descriptor instead.
 func (*BlobInfoPage) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{3}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BlobInfoPage) GetTotal() int64 {
@@ -377,12 +475,13 @@ type BlobInfoPageRequest struct {
 	CorrelationId string            `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
 	Filter        map[string]string `protobuf:"bytes,2,rep,name=filter,proto3" json:"filter,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Paging        *PagingParams     `protobuf:"bytes,3,opt,name=paging,proto3" json:"paging,omitempty"`
+	Sort          []*SortField      `protobuf:"bytes,4,rep,name=sort,proto3" json:"sort,omitempty"`
 }
 
 func (x *BlobInfoPageRequest) Reset() {
 	*x = BlobInfoPageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[4]
+		mi := &file_protos_blobs_v1_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -395,7 +494,7 @@ func (x *BlobInfoPageRequest) String() string {
 func (*BlobInfoPageRequest) ProtoMessage() {}
 
 func (x *BlobInfoPageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[4]
+	mi := &file_protos_blobs_v1_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +507,7 @@ func (x *BlobInfoPageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoPageRequest.ProtoReflect.Descriptor instead.
 func (*BlobInfoPageRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{4}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *BlobInfoPageRequest) GetCorrelationId() string {
@@ -432,6 +531,13 @@ func (x *BlobInfoPageRequest) GetPaging() *PagingParams {
 	return nil
 }
 
+func (x *BlobInfoPageRequest) GetSort() []*SortField {
+	if x != nil {
+		return x.Sort
+	}
+	return nil
+}
+
 // The response message containing the blob info page response
 type BlobInfoPageReply struct {
 	state         protoimpl.MessageState
@@ -445,7 +551,7 @@ type BlobInfoPageReply struct {
 func (x *BlobInfoPageReply) Reset() {
 	*x = BlobInfoPageReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[5]
+		mi := &file_protos_blobs_v1_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -458,7 +564,7 @@ func (x *BlobInfoPageReply) String() string {
 func (*BlobInfoPageReply) ProtoMessage() {}
 
 func (x *BlobInfoPageReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[5]
+	mi := &file_protos_blobs_v1_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +577,7 @@ func (x *BlobInfoPageReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoPageReply.ProtoReflect.Descriptor instead.
 func (*BlobInfoPageReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{5}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *BlobInfoPageReply) GetError() *ErrorDescription {
@@ -501,7 +607,7 @@ type BlobIdsRequest struct {
 func (x *BlobIdsRequest) Reset() {
 	*x = BlobIdsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[6]
+		mi := &file_protos_blobs_v1_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -514,7 +620,7 @@ func (x *BlobIdsRequest) String() string {
 func (*BlobIdsRequest) ProtoMessage() {}
 
 func (x *BlobIdsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[6]
+	mi := &file_protos_blobs_v1_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -527,7 +633,7 @@ func (x *BlobIdsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobIdsRequest.ProtoReflect.Descriptor instead.
 func (*BlobIdsRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{6}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *BlobIdsRequest) GetCorrelationId() string {
@@ -557,7 +663,7 @@ type BlobIdRequest struct {
 func (x *BlobIdRequest) Reset() {
 	*x = BlobIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[7]
+		mi := &file_protos_blobs_v1_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -570,7 +676,7 @@ func (x *BlobIdRequest) String() string {
 func (*BlobIdRequest) ProtoMessage() {}
 
 func (x *BlobIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[7]
+	mi := &file_protos_blobs_v1_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,7 +689,7 @@ func (x *BlobIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobIdRequest.ProtoReflect.Descriptor instead.
 func (*BlobIdRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{7}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BlobIdRequest) GetCorrelationId() string {
@@ -613,7 +719,7 @@ type BlobInfoObjectRequest struct {
 func (x *BlobInfoObjectRequest) Reset() {
 	*x = BlobInfoObjectRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[8]
+		mi := &file_protos_blobs_v1_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -626,7 +732,7 @@ func (x *BlobInfoObjectRequest) String() string {
 func (*BlobInfoObjectRequest) ProtoMessage() {}
 
 func (x *BlobInfoObjectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[8]
+	mi := &file_protos_blobs_v1_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -639,7 +745,7 @@ func (x *BlobInfoObjectRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoObjectRequest.ProtoReflect.Descriptor instead.
 func (*BlobInfoObjectRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{8}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *BlobInfoObjectRequest) GetCorrelationId() string {
@@ -669,7 +775,7 @@ type BlobInfoObjectsReply struct {
 func (x *BlobInfoObjectsReply) Reset() {
 	*x = BlobInfoObjectsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[9]
+		mi := &file_protos_blobs_v1_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -682,7 +788,7 @@ func (x *BlobInfoObjectsReply) String() string {
 func (*BlobInfoObjectsReply) ProtoMessage() {}
 
 func (x *BlobInfoObjectsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[9]
+	mi := &file_protos_blobs_v1_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,7 +801,7 @@ func (x *BlobInfoObjectsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoObjectsReply.ProtoReflect.Descriptor instead.
 func (*BlobInfoObjectsReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{9}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BlobInfoObjectsReply) GetError() *ErrorDescription {
@@ -725,7 +831,7 @@ type BlobInfoObjectReply struct {
 func (x *BlobInfoObjectReply) Reset() {
 	*x = BlobInfoObjectReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[10]
+		mi := &file_protos_blobs_v1_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -738,7 +844,7 @@ func (x *BlobInfoObjectReply) String() string {
 func (*BlobInfoObjectReply) ProtoMessage() {}
 
 func (x *BlobInfoObjectReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[10]
+	mi := &file_protos_blobs_v1_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -751,7 +857,7 @@ func (x *BlobInfoObjectReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobInfoObjectReply.ProtoReflect.Descriptor instead.
 func (*BlobInfoObjectReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{10}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BlobInfoObjectReply) GetError() *ErrorDescription {
@@ -781,7 +887,7 @@ type BlobUriReply struct {
 func (x *BlobUriReply) Reset() {
 	*x = BlobUriReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[11]
+		mi := &file_protos_blobs_v1_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -794,7 +900,7 @@ func (x *BlobUriReply) String() string {
 func (*BlobUriReply) ProtoMessage() {}
 
 func (x *BlobUriReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[11]
+	mi := &file_protos_blobs_v1_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +913,7 @@ func (x *BlobUriReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobUriReply.ProtoReflect.Descriptor instead.
 func (*BlobUriReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{11}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *BlobUriReply) GetError() *ErrorDescription {
@@ -837,7 +943,7 @@ type BlobTokenRequest struct {
 func (x *BlobTokenRequest) Reset() {
 	*x = BlobTokenRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[12]
+		mi := &file_protos_blobs_v1_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -850,7 +956,7 @@ func (x *BlobTokenRequest) String() string {
 func (*BlobTokenRequest) ProtoMessage() {}
 
 func (x *BlobTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[12]
+	mi := &file_protos_blobs_v1_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -863,7 +969,7 @@ func (x *BlobTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobTokenRequest.ProtoReflect.Descriptor instead.
 func (*BlobTokenRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{12}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *BlobTokenRequest) GetCorrelationId() string {
@@ -894,7 +1000,7 @@ type BlobTokenWithChunkRequest struct {
 func (x *BlobTokenWithChunkRequest) Reset() {
 	*x = BlobTokenWithChunkRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[13]
+		mi := &file_protos_blobs_v1_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -907,7 +1013,7 @@ func (x *BlobTokenWithChunkRequest) String() string {
 func (*BlobTokenWithChunkRequest) ProtoMessage() {}
 
 func (x *BlobTokenWithChunkRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[13]
+	mi := &file_protos_blobs_v1_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -920,7 +1026,7 @@ func (x *BlobTokenWithChunkRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobTokenWithChunkRequest.ProtoReflect.Descriptor instead.
 func (*BlobTokenWithChunkRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{13}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *BlobTokenWithChunkRequest) GetCorrelationId() string {
@@ -944,33 +1050,36 @@ func (x *BlobTokenWithChunkRequest) GetChunk() string {
 	return ""
 }
 
-// The response message containing the blob token response
-type BlobTokenReply struct {
+// The request message containing the blob part request.
+// Unlike the older chunk calls, content is sent as raw bytes.
+type BlobPartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Token string            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Offset        int64  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Chunk         []byte `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
 }
 
-func (x *BlobTokenReply) Reset() {
-	*x = BlobTokenReply{}
+func (x *BlobPartRequest) Reset() {
+	*x = BlobPartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[14]
+		mi := &file_protos_blobs_v1_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlobTokenReply) String() string {
+func (x *BlobPartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlobTokenReply) ProtoMessage() {}
+func (*BlobPartRequest) ProtoMessage() {}
 
-func (x *BlobTokenReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[14]
+func (x *BlobPartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -981,51 +1090,66 @@ func (x *BlobTokenReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlobTokenReply.ProtoReflect.Descriptor instead.
-func (*BlobTokenReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use BlobPartRequest.ProtoReflect.Descriptor instead.
+func (*BlobPartRequest) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BlobTokenReply) GetError() *ErrorDescription {
+func (x *BlobPartRequest) GetCorrelationId() string {
 	if x != nil {
-		return x.Error
+		return x.CorrelationId
 	}
-	return nil
+	return ""
 }
 
-func (x *BlobTokenReply) GetToken() string {
+func (x *BlobPartRequest) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-// The response message containing the blob empty response
-type BlobEmptyReply struct {
+func (x *BlobPartRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BlobPartRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// The response message containing the blob token response
+type BlobTokenReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Error *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Token string            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *BlobEmptyReply) Reset() {
-	*x = BlobEmptyReply{}
+func (x *BlobTokenReply) Reset() {
+	*x = BlobTokenReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[15]
+		mi := &file_protos_blobs_v1_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlobEmptyReply) String() string {
+func (x *BlobTokenReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlobEmptyReply) ProtoMessage() {}
+func (*BlobTokenReply) ProtoMessage() {}
 
-func (x *BlobEmptyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[15]
+func (x *BlobTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,47 +1160,53 @@ func (x *BlobEmptyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlobEmptyReply.ProtoReflect.Descriptor instead.
-func (*BlobEmptyReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use BlobTokenReply.ProtoReflect.Descriptor instead.
+func (*BlobTokenReply) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *BlobEmptyReply) GetError() *ErrorDescription {
+func (x *BlobTokenReply) GetError() *ErrorDescription {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-// The request message containing the blob read request.
-type BlobReadRequest struct {
+func (x *BlobTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// The response message containing the blob offset response
+type BlobOffsetReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
-	BlobId        string `protobuf:"bytes,2,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
-	Skip          int64  `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
-	Take          int64  `protobuf:"varint,4,opt,name=take,proto3" json:"take,omitempty"`
+	Error  *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Offset int64             `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Token  string            `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *BlobReadRequest) Reset() {
-	*x = BlobReadRequest{}
+func (x *BlobOffsetReply) Reset() {
+	*x = BlobOffsetReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[16]
+		mi := &file_protos_blobs_v1_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlobReadRequest) String() string {
+func (x *BlobOffsetReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlobReadRequest) ProtoMessage() {}
+func (*BlobOffsetReply) ProtoMessage() {}
 
-func (x *BlobReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[16]
+func (x *BlobOffsetReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1087,41 +1217,154 @@ func (x *BlobReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlobReadRequest.ProtoReflect.Descriptor instead.
-func (*BlobReadRequest) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *BlobReadRequest) GetCorrelationId() string {
-	if x != nil {
-		return x.CorrelationId
-	}
-	return ""
+// Deprecated: Use BlobOffsetReply.ProtoReflect.Descriptor instead.
+func (*BlobOffsetReply) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *BlobReadRequest) GetBlobId() string {
+func (x *BlobOffsetReply) GetError() *ErrorDescription {
 	if x != nil {
-		return x.BlobId
+		return x.Error
 	}
-	return ""
+	return nil
 }
 
-func (x *BlobReadRequest) GetSkip() int64 {
+func (x *BlobOffsetReply) GetOffset() int64 {
 	if x != nil {
-		return x.Skip
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *BlobReadRequest) GetTake() int64 {
+func (x *BlobOffsetReply) GetToken() string {
 	if x != nil {
-		return x.Take
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-// The response message containing the blob chunk response
-type BlobChunkReply struct {
+// The response message containing the blob empty response
+type BlobEmptyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BlobEmptyReply) Reset() {
+	*x = BlobEmptyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobEmptyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobEmptyReply) ProtoMessage() {}
+
+func (x *BlobEmptyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobEmptyReply.ProtoReflect.Descriptor instead.
+func (*BlobEmptyReply) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *BlobEmptyReply) GetError() *ErrorDescription {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// The request message containing the blob read request.
+type BlobReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	BlobId        string `protobuf:"bytes,2,opt,name=blob_id,json=blobId,proto3" json:"blob_id,omitempty"`
+	Skip          int64  `protobuf:"varint,3,opt,name=skip,proto3" json:"skip,omitempty"`
+	Take          int64  `protobuf:"varint,4,opt,name=take,proto3" json:"take,omitempty"`
+}
+
+func (x *BlobReadRequest) Reset() {
+	*x = BlobReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobReadRequest) ProtoMessage() {}
+
+func (x *BlobReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobReadRequest.ProtoReflect.Descriptor instead.
+func (*BlobReadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BlobReadRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BlobReadRequest) GetBlobId() string {
+	if x != nil {
+		return x.BlobId
+	}
+	return ""
+}
+
+func (x *BlobReadRequest) GetSkip() int64 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *BlobReadRequest) GetTake() int64 {
+	if x != nil {
+		return x.Take
+	}
+	return 0
+}
+
+// The response message containing the blob chunk response
+type BlobChunkReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1133,7 +1376,7 @@ type BlobChunkReply struct {
 func (x *BlobChunkReply) Reset() {
 	*x = BlobChunkReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protos_blobs_v1_proto_msgTypes[17]
+		mi := &file_protos_blobs_v1_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +1389,7 @@ func (x *BlobChunkReply) String() string {
 func (*BlobChunkReply) ProtoMessage() {}
 
 func (x *BlobChunkReply) ProtoReflect() protoreflect.Message {
-	mi := &file_protos_blobs_v1_proto_msgTypes[17]
+	mi := &file_protos_blobs_v1_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1402,7 @@ func (x *BlobChunkReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlobChunkReply.ProtoReflect.Descriptor instead.
 func (*BlobChunkReply) Descriptor() ([]byte, []int) {
-	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{17}
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *BlobChunkReply) GetError() *ErrorDescription {
@@ -1176,6 +1419,240 @@ func (x *BlobChunkReply) GetChunk() string {
 	return ""
 }
 
+// The request message containing the blob upload request.
+// Blob info is sent in the first message, content in the following ones.
+type BlobUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string    `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	Blob          *BlobInfo `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Chunk         []byte    `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BlobUploadRequest) Reset() {
+	*x = BlobUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobUploadRequest) ProtoMessage() {}
+
+func (x *BlobUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobUploadRequest.ProtoReflect.Descriptor instead.
+func (*BlobUploadRequest) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlobUploadRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+func (x *BlobUploadRequest) GetBlob() *BlobInfo {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *BlobUploadRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// The response message containing the blob download response.
+// Blob info is sent in the first message, content in the following ones.
+type BlobDownloadReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Blob  *BlobInfo         `protobuf:"bytes,2,opt,name=blob,proto3" json:"blob,omitempty"`
+	Chunk []byte            `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BlobDownloadReply) Reset() {
+	*x = BlobDownloadReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobDownloadReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobDownloadReply) ProtoMessage() {}
+
+func (x *BlobDownloadReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobDownloadReply.ProtoReflect.Descriptor instead.
+func (*BlobDownloadReply) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlobDownloadReply) GetError() *ErrorDescription {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *BlobDownloadReply) GetBlob() *BlobInfo {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *BlobDownloadReply) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// The request message containing the blob features request.
+type BlobFeaturesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CorrelationId string `protobuf:"bytes,1,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+}
+
+func (x *BlobFeaturesRequest) Reset() {
+	*x = BlobFeaturesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobFeaturesRequest) ProtoMessage() {}
+
+func (x *BlobFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*BlobFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *BlobFeaturesRequest) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// The response message containing the blob features response
+type BlobFeaturesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error    *ErrorDescription `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Features []string          `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *BlobFeaturesReply) Reset() {
+	*x = BlobFeaturesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protos_blobs_v1_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobFeaturesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobFeaturesReply) ProtoMessage() {}
+
+func (x *BlobFeaturesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_protos_blobs_v1_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobFeaturesReply.ProtoReflect.Descriptor instead.
+func (*BlobFeaturesReply) Descriptor() ([]byte, []int) {
+	return file_protos_blobs_v1_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BlobFeaturesReply) GetError() *ErrorDescription {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *BlobFeaturesReply) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
 var File_protos_blobs_v1_proto protoreflect.FileDescriptor
 
 var file_protos_blobs_v1_proto_rawDesc = []byte{
@@ -1208,200 +1685,288 @@ var file_protos_blobs_v1_proto_rawDesc = []byte{
 	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a,
 	0x04, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x61, 0x6b,
 	0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x62,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69,
-	0x7a, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66,
-	0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0xea, 0x01, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c,
-	0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x71, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x3d, 0x0a, 0x09, 0x53, 0x6f, 0x72, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x73, 0x63,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0xe0, 0x03, 0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d,
+	0x5f, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12,
+	0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x63,
+	0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x3b, 0x0a, 0x0d,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4c, 0x0a, 0x0c, 0x42, 0x6c, 0x6f,
+	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x93, 0x02, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x62,
+	0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x06, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x6f, 0x72,
+	0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
+	0x76, 0x31, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x73, 0x6f,
+	0x72, 0x74, 0x1a, 0x39, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x71, 0x0a,
+	0x11, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
+	0x22, 0x52, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6f,
+	0x62, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
+	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x6c, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66,
+	0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x72, 0x0a,
+	0x14, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
 	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
 	0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x22, 0x52, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08,
-	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x62, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x62, 0x49,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72,
-	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x62, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x66, 0x0a, 0x15, 0x42, 0x6c, 0x6f, 0x62,
-	0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62,
-	0x22, 0x72, 0x0a, 0x14, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x22, 0x6f, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
 	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x05, 0x62, 0x6c,
-	0x6f, 0x62, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x22, 0x6f, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6c,
+	0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6c,
+	0x6f, 0x62, 0x22, 0x52, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x55, 0x72, 0x69, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x4f, 0x0a, 0x10, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x19, 0x42, 0x6c, 0x6f, 0x62, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x7c, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x62, 0x50,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x58, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x71, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x62, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x42, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x79, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69,
+	0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x61, 0x6b,
+	0x65, 0x22, 0x58, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x78, 0x0a, 0x11, 0x42,
+	0x6c, 0x6f, 0x62, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x83, 0x01, 0x0a, 0x11, 0x42, 0x6c, 0x6f, 0x62, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65,
 	0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f,
 	0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a,
 	0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x62, 0x6c,
 	0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x04, 0x62, 0x6c, 0x6f, 0x62, 0x22, 0x52, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x55, 0x72, 0x69,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x22, 0x4f, 0x0a, 0x10, 0x42, 0x6c, 0x6f,
-	0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6e, 0x0a, 0x19, 0x42, 0x6c,
-	0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65,
-	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x58, 0x0a, 0x0e, 0x42, 0x6c,
-	0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c,
-	0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x79, 0x0a, 0x0f, 0x42, 0x6c, 0x6f, 0x62,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63,
-	0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6b, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74,
-	0x61, 0x6b, 0x65, 0x22, 0x58, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x32, 0xa3, 0x09,
-	0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e,
-	0x66, 0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x3c, 0x0a, 0x13, 0x42,
+	0x6c, 0x6f, 0x62, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x72, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x11, 0x42, 0x6c, 0x6f,
+	0x62, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x30,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x32, 0xa7, 0x0c, 0x0a,
+	0x05, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x53, 0x0a, 0x13, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x2e,
 	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66,
-	0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10,
-	0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x73,
-	0x12, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62,
-	0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6c, 0x6f,
-	0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e,
-	0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x17,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64,
+	0x6f, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x67,
+	0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x12,
+	0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49,
+	0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x67,
+	0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f, 0x62,
+	0x6c, 0x6f, 0x62, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x55, 0x72, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x10, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x53, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
+	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68,
+	0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f,
+	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x12, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x50, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x56, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
 	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x12, 0x67, 0x65, 0x74, 0x5f,
-	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x75, 0x72, 0x69, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x12, 0x17,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
-	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x55, 0x72, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x4f, 0x0a, 0x10, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f,
-	0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31,
-	0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x53, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
-	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c,
-	0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x0e, 0x65, 0x6e, 0x64, 0x5f, 0x62,
-	0x6c, 0x6f, 0x62, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
-	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x57, 0x69,
-	0x74, 0x68, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
-	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e,
-	0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x4a, 0x0a, 0x10, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x77, 0x72,
-	0x69, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42,
-	0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x62,
-	0x65, 0x67, 0x69, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x17,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x61, 0x62, 0x6f, 0x72,
+	0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x15, 0x67, 0x65, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x0f, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x5f,
+	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62,
+	0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
+	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
+	0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a,
+	0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x17,
 	0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
-	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64,
-	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x19, 0x2e, 0x62, 0x6c,
-	0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x65, 0x61, 0x64, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x44, 0x0a, 0x0d, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x72,
-	0x65, 0x61, 0x64, 0x12, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42,
-	0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x62,
-	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f,
-	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x6e, 0x66,
-	0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4c,
-	0x0a, 0x14, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x63, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62, 0x79, 0x5f, 0x69,
-	0x64, 0x12, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f,
-	0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f,
-	0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x12, 0x18, 0x2e,
-	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
 	0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x42, 0x55, 0x0a, 0x2a, 0x70, 0x69, 0x70, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x31, 0x42, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x31, 0x50,
-	0x01, 0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0xa2, 0x02, 0x0c, 0x42, 0x4c,
-	0x4f, 0x42, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0b, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x6c,
+	0x6f, 0x62, 0x12, 0x1b, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x62, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x28, 0x01, 0x12, 0x49, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62,
+	0x6c, 0x6f, 0x62, 0x12, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x44, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4c, 0x0a,
+	0x0c, 0x67, 0x65, 0x74, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x46, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x62,
+	0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x46, 0x65, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x10, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x12,
+	0x1f, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49,
+	0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62,
+	0x49, 0x6e, 0x66, 0x6f, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x14, 0x6d, 0x61, 0x72, 0x6b, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62,
+	0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42,
+	0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x48, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x62,
+	0x79, 0x5f, 0x69, 0x64, 0x12, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e,
+	0x42, 0x6c, 0x6f, 0x62, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x13, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x73,
+	0x12, 0x18, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62,
+	0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x5f, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x55, 0x0a, 0x2a, 0x70, 0x69, 0x70, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x69, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x2e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x31, 0x42, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x56, 0x31, 0x50, 0x01, 0x5a, 0x08, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0xa2, 0x02,
+	0x0c, 0x42, 0x4c, 0x4f, 0x42, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x56, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1416,80 +1981,105 @@ func file_protos_blobs_v1_proto_rawDescGZIP() []byte {
 	return file_protos_blobs_v1_proto_rawDescData
 }
 
-var file_protos_blobs_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_protos_blobs_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_protos_blobs_v1_proto_goTypes = []interface{}{
 	(*ErrorDescription)(nil),          // 0: blobs_v1.ErrorDescription
 	(*PagingParams)(nil),              // 1: blobs_v1.PagingParams
-	(*BlobInfo)(nil),                  // 2: blobs_v1.BlobInfo
-	(*BlobInfoPage)(nil),              // 3: blobs_v1.BlobInfoPage
-	(*BlobInfoPageRequest)(nil),       // 4: blobs_v1.BlobInfoPageRequest
-	(*BlobInfoPageReply)(nil),         // 5: blobs_v1.BlobInfoPageReply
-	(*BlobIdsRequest)(nil),            // 6: blobs_v1.BlobIdsRequest
-	(*BlobIdRequest)(nil),             // 7: blobs_v1.BlobIdRequest
-	(*BlobInfoObjectRequest)(nil),     // 8: blobs_v1.BlobInfoObjectRequest
-	(*BlobInfoObjectsReply)(nil),      // 9: blobs_v1.BlobInfoObjectsReply
-	(*BlobInfoObjectReply)(nil),       // 10: blobs_v1.BlobInfoObjectReply
-	(*BlobUriReply)(nil),              // 11: blobs_v1.BlobUriReply
-	(*BlobTokenRequest)(nil),          // 12: blobs_v1.BlobTokenRequest
-	(*BlobTokenWithChunkRequest)(nil), // 13: blobs_v1.BlobTokenWithChunkRequest
-	(*BlobTokenReply)(nil),            // 14: blobs_v1.BlobTokenReply
-	(*BlobEmptyReply)(nil),            // 15: blobs_v1.BlobEmptyReply
-	(*BlobReadRequest)(nil),           // 16: blobs_v1.BlobReadRequest
-	(*BlobChunkReply)(nil),            // 17: blobs_v1.BlobChunkReply
-	nil,                               // 18: blobs_v1.ErrorDescription.DetailsEntry
-	nil,                               // 19: blobs_v1.BlobInfoPageRequest.FilterEntry
+	(*SortField)(nil),                 // 2: blobs_v1.SortField
+	(*BlobInfo)(nil),                  // 3: blobs_v1.BlobInfo
+	(*BlobInfoPage)(nil),              // 4: blobs_v1.BlobInfoPage
+	(*BlobInfoPageRequest)(nil),       // 5: blobs_v1.BlobInfoPageRequest
+	(*BlobInfoPageReply)(nil),         // 6: blobs_v1.BlobInfoPageReply
+	(*BlobIdsRequest)(nil),            // 7: blobs_v1.BlobIdsRequest
+	(*BlobIdRequest)(nil),             // 8: blobs_v1.BlobIdRequest
+	(*BlobInfoObjectRequest)(nil),     // 9: blobs_v1.BlobInfoObjectRequest
+	(*BlobInfoObjectsReply)(nil),      // 10: blobs_v1.BlobInfoObjectsReply
+	(*BlobInfoObjectReply)(nil),       // 11: blobs_v1.BlobInfoObjectReply
+	(*BlobUriReply)(nil),              // 12: blobs_v1.BlobUriReply
+	(*BlobTokenRequest)(nil),          // 13: blobs_v1.BlobTokenRequest
+	(*BlobTokenWithChunkRequest)(nil), // 14: blobs_v1.BlobTokenWithChunkRequest
+	(*BlobPartRequest)(nil),           // 15: blobs_v1.BlobPartRequest
+	(*BlobTokenReply)(nil),            // 16: blobs_v1.BlobTokenReply
+	(*BlobOffsetReply)(nil),           // 17: blobs_v1.BlobOffsetReply
+	(*BlobEmptyReply)(nil),            // 18: blobs_v1.BlobEmptyReply
+	(*BlobReadRequest)(nil),           // 19: blobs_v1.BlobReadRequest
+	(*BlobChunkReply)(nil),            // 20: blobs_v1.BlobChunkReply
+	(*BlobUploadRequest)(nil),         // 21: blobs_v1.BlobUploadRequest
+	(*BlobDownloadReply)(nil),         // 22: blobs_v1.BlobDownloadReply
+	(*BlobFeaturesRequest)(nil),       // 23: blobs_v1.BlobFeaturesRequest
+	(*BlobFeaturesReply)(nil),         // 24: blobs_v1.BlobFeaturesReply
+	nil,                               // 25: blobs_v1.ErrorDescription.DetailsEntry
+	nil,                               // 26: blobs_v1.BlobInfo.MetadataEntry
+	nil,                               // 27: blobs_v1.BlobInfoPageRequest.FilterEntry
 }
 var file_protos_blobs_v1_proto_depIdxs = []int32{
-	18, // 0: blobs_v1.ErrorDescription.details:type_name -> blobs_v1.ErrorDescription.DetailsEntry
-	2,  // 1: blobs_v1.BlobInfoPage.data:type_name -> blobs_v1.BlobInfo
-	19, // 2: blobs_v1.BlobInfoPageRequest.filter:type_name -> blobs_v1.BlobInfoPageRequest.FilterEntry
-	1,  // 3: blobs_v1.BlobInfoPageRequest.paging:type_name -> blobs_v1.PagingParams
-	0,  // 4: blobs_v1.BlobInfoPageReply.error:type_name -> blobs_v1.ErrorDescription
-	3,  // 5: blobs_v1.BlobInfoPageReply.page:type_name -> blobs_v1.BlobInfoPage
-	2,  // 6: blobs_v1.BlobInfoObjectRequest.blob:type_name -> blobs_v1.BlobInfo
-	0,  // 7: blobs_v1.BlobInfoObjectsReply.error:type_name -> blobs_v1.ErrorDescription
-	2,  // 8: blobs_v1.BlobInfoObjectsReply.blobs:type_name -> blobs_v1.BlobInfo
-	0,  // 9: blobs_v1.BlobInfoObjectReply.error:type_name -> blobs_v1.ErrorDescription
-	2,  // 10: blobs_v1.BlobInfoObjectReply.blob:type_name -> blobs_v1.BlobInfo
-	0,  // 11: blobs_v1.BlobUriReply.error:type_name -> blobs_v1.ErrorDescription
-	0,  // 12: blobs_v1.BlobTokenReply.error:type_name -> blobs_v1.ErrorDescription
-	0,  // 13: blobs_v1.BlobEmptyReply.error:type_name -> blobs_v1.ErrorDescription
-	0,  // 14: blobs_v1.BlobChunkReply.error:type_name -> blobs_v1.ErrorDescription
-	4,  // 15: blobs_v1.Blobs.get_blobs_by_filter:input_type -> blobs_v1.BlobInfoPageRequest
-	6,  // 16: blobs_v1.Blobs.get_blobs_by_ids:input_type -> blobs_v1.BlobIdsRequest
-	7,  // 17: blobs_v1.Blobs.get_blob_by_id:input_type -> blobs_v1.BlobIdRequest
-	7,  // 18: blobs_v1.Blobs.get_blob_uri_by_id:input_type -> blobs_v1.BlobIdRequest
-	8,  // 19: blobs_v1.Blobs.begin_blob_write:input_type -> blobs_v1.BlobInfoObjectRequest
-	13, // 20: blobs_v1.Blobs.write_blob_chunk:input_type -> blobs_v1.BlobTokenWithChunkRequest
-	13, // 21: blobs_v1.Blobs.end_blob_write:input_type -> blobs_v1.BlobTokenWithChunkRequest
-	12, // 22: blobs_v1.Blobs.abort_blob_write:input_type -> blobs_v1.BlobTokenRequest
-	7,  // 23: blobs_v1.Blobs.begin_blob_read:input_type -> blobs_v1.BlobIdRequest
-	16, // 24: blobs_v1.Blobs.read_blob_chunk:input_type -> blobs_v1.BlobReadRequest
-	7,  // 25: blobs_v1.Blobs.end_blob_read:input_type -> blobs_v1.BlobIdRequest
-	8,  // 26: blobs_v1.Blobs.update_blob_info:input_type -> blobs_v1.BlobInfoObjectRequest
-	6,  // 27: blobs_v1.Blobs.mark_blobs_completed:input_type -> blobs_v1.BlobIdsRequest
-	7,  // 28: blobs_v1.Blobs.delete_blob_by_id:input_type -> blobs_v1.BlobIdRequest
-	6,  // 29: blobs_v1.Blobs.delete_blobs_by_ids:input_type -> blobs_v1.BlobIdsRequest
-	5,  // 30: blobs_v1.Blobs.get_blobs_by_filter:output_type -> blobs_v1.BlobInfoPageReply
-	9,  // 31: blobs_v1.Blobs.get_blobs_by_ids:output_type -> blobs_v1.BlobInfoObjectsReply
-	10, // 32: blobs_v1.Blobs.get_blob_by_id:output_type -> blobs_v1.BlobInfoObjectReply
-	11, // 33: blobs_v1.Blobs.get_blob_uri_by_id:output_type -> blobs_v1.BlobUriReply
-	14, // 34: blobs_v1.Blobs.begin_blob_write:output_type -> blobs_v1.BlobTokenReply
-	14, // 35: blobs_v1.Blobs.write_blob_chunk:output_type -> blobs_v1.BlobTokenReply
-	10, // 36: blobs_v1.Blobs.end_blob_write:output_type -> blobs_v1.BlobInfoObjectReply
-	15, // 37: blobs_v1.Blobs.abort_blob_write:output_type -> blobs_v1.BlobEmptyReply
-	10, // 38: blobs_v1.Blobs.begin_blob_read:output_type -> blobs_v1.BlobInfoObjectReply
-	17, // 39: blobs_v1.Blobs.read_blob_chunk:output_type -> blobs_v1.BlobChunkReply
-	15, // 40: blobs_v1.Blobs.end_blob_read:output_type -> blobs_v1.BlobEmptyReply
-	10, // 41: blobs_v1.Blobs.update_blob_info:output_type -> blobs_v1.BlobInfoObjectReply
-	15, // 42: blobs_v1.Blobs.mark_blobs_completed:output_type -> blobs_v1.BlobEmptyReply
-	15, // 43: blobs_v1.Blobs.delete_blob_by_id:output_type -> blobs_v1.BlobEmptyReply
-	15, // 44: blobs_v1.Blobs.delete_blobs_by_ids:output_type -> blobs_v1.BlobEmptyReply
-	30, // [30:45] is the sub-list for method output_type
-	15, // [15:30] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	25, // 0: blobs_v1.ErrorDescription.details:type_name -> blobs_v1.ErrorDescription.DetailsEntry
+	26, // 1: blobs_v1.BlobInfo.metadata:type_name -> blobs_v1.BlobInfo.MetadataEntry
+	3,  // 2: blobs_v1.BlobInfoPage.data:type_name -> blobs_v1.BlobInfo
+	27, // 3: blobs_v1.BlobInfoPageRequest.filter:type_name -> blobs_v1.BlobInfoPageRequest.FilterEntry
+	1,  // 4: blobs_v1.BlobInfoPageRequest.paging:type_name -> blobs_v1.PagingParams
+	2,  // 5: blobs_v1.BlobInfoPageRequest.sort:type_name -> blobs_v1.SortField
+	0,  // 6: blobs_v1.BlobInfoPageReply.error:type_name -> blobs_v1.ErrorDescription
+	4,  // 7: blobs_v1.BlobInfoPageReply.page:type_name -> blobs_v1.BlobInfoPage
+	3,  // 8: blobs_v1.BlobInfoObjectRequest.blob:type_name -> blobs_v1.BlobInfo
+	0,  // 9: blobs_v1.BlobInfoObjectsReply.error:type_name -> blobs_v1.ErrorDescription
+	3,  // 10: blobs_v1.BlobInfoObjectsReply.blobs:type_name -> blobs_v1.BlobInfo
+	0,  // 11: blobs_v1.BlobInfoObjectReply.error:type_name -> blobs_v1.ErrorDescription
+	3,  // 12: blobs_v1.BlobInfoObjectReply.blob:type_name -> blobs_v1.BlobInfo
+	0,  // 13: blobs_v1.BlobUriReply.error:type_name -> blobs_v1.ErrorDescription
+	0,  // 14: blobs_v1.BlobTokenReply.error:type_name -> blobs_v1.ErrorDescription
+	0,  // 15: blobs_v1.BlobOffsetReply.error:type_name -> blobs_v1.ErrorDescription
+	0,  // 16: blobs_v1.BlobEmptyReply.error:type_name -> blobs_v1.ErrorDescription
+	0,  // 17: blobs_v1.BlobChunkReply.error:type_name -> blobs_v1.ErrorDescription
+	3,  // 18: blobs_v1.BlobUploadRequest.blob:type_name -> blobs_v1.BlobInfo
+	0,  // 19: blobs_v1.BlobDownloadReply.error:type_name -> blobs_v1.ErrorDescription
+	3,  // 20: blobs_v1.BlobDownloadReply.blob:type_name -> blobs_v1.BlobInfo
+	0,  // 21: blobs_v1.BlobFeaturesReply.error:type_name -> blobs_v1.ErrorDescription
+	5,  // 22: blobs_v1.Blobs.get_blobs_by_filter:input_type -> blobs_v1.BlobInfoPageRequest
+	7,  // 23: blobs_v1.Blobs.get_blobs_by_ids:input_type -> blobs_v1.BlobIdsRequest
+	8,  // 24: blobs_v1.Blobs.get_blob_by_id:input_type -> blobs_v1.BlobIdRequest
+	8,  // 25: blobs_v1.Blobs.get_blob_uri_by_id:input_type -> blobs_v1.BlobIdRequest
+	9,  // 26: blobs_v1.Blobs.begin_blob_write:input_type -> blobs_v1.BlobInfoObjectRequest
+	14, // 27: blobs_v1.Blobs.write_blob_chunk:input_type -> blobs_v1.BlobTokenWithChunkRequest
+	15, // 28: blobs_v1.Blobs.write_blob_part:input_type -> blobs_v1.BlobPartRequest
+	14, // 29: blobs_v1.Blobs.end_blob_write:input_type -> blobs_v1.BlobTokenWithChunkRequest
+	13, // 30: blobs_v1.Blobs.abort_blob_write:input_type -> blobs_v1.BlobTokenRequest
+	13, // 31: blobs_v1.Blobs.get_blob_write_offset:input_type -> blobs_v1.BlobTokenRequest
+	8,  // 32: blobs_v1.Blobs.begin_blob_read:input_type -> blobs_v1.BlobIdRequest
+	19, // 33: blobs_v1.Blobs.read_blob_chunk:input_type -> blobs_v1.BlobReadRequest
+	8,  // 34: blobs_v1.Blobs.end_blob_read:input_type -> blobs_v1.BlobIdRequest
+	21, // 35: blobs_v1.Blobs.upload_blob:input_type -> blobs_v1.BlobUploadRequest
+	8,  // 36: blobs_v1.Blobs.download_blob:input_type -> blobs_v1.BlobIdRequest
+	23, // 37: blobs_v1.Blobs.get_features:input_type -> blobs_v1.BlobFeaturesRequest
+	9,  // 38: blobs_v1.Blobs.update_blob_info:input_type -> blobs_v1.BlobInfoObjectRequest
+	7,  // 39: blobs_v1.Blobs.mark_blobs_completed:input_type -> blobs_v1.BlobIdsRequest
+	8,  // 40: blobs_v1.Blobs.delete_blob_by_id:input_type -> blobs_v1.BlobIdRequest
+	7,  // 41: blobs_v1.Blobs.delete_blobs_by_ids:input_type -> blobs_v1.BlobIdsRequest
+	6,  // 42: blobs_v1.Blobs.get_blobs_by_filter:output_type -> blobs_v1.BlobInfoPageReply
+	10, // 43: blobs_v1.Blobs.get_blobs_by_ids:output_type -> blobs_v1.BlobInfoObjectsReply
+	11, // 44: blobs_v1.Blobs.get_blob_by_id:output_type -> blobs_v1.BlobInfoObjectReply
+	12, // 45: blobs_v1.Blobs.get_blob_uri_by_id:output_type -> blobs_v1.BlobUriReply
+	16, // 46: blobs_v1.Blobs.begin_blob_write:output_type -> blobs_v1.BlobTokenReply
+	16, // 47: blobs_v1.Blobs.write_blob_chunk:output_type -> blobs_v1.BlobTokenReply
+	18, // 48: blobs_v1.Blobs.write_blob_part:output_type -> blobs_v1.BlobEmptyReply
+	11, // 49: blobs_v1.Blobs.end_blob_write:output_type -> blobs_v1.BlobInfoObjectReply
+	18, // 50: blobs_v1.Blobs.abort_blob_write:output_type -> blobs_v1.BlobEmptyReply
+	17, // 51: blobs_v1.Blobs.get_blob_write_offset:output_type -> blobs_v1.BlobOffsetReply
+	11, // 52: blobs_v1.Blobs.begin_blob_read:output_type -> blobs_v1.BlobInfoObjectReply
+	20, // 53: blobs_v1.Blobs.read_blob_chunk:output_type -> blobs_v1.BlobChunkReply
+	18, // 54: blobs_v1.Blobs.end_blob_read:output_type -> blobs_v1.BlobEmptyReply
+	11, // 55: blobs_v1.Blobs.upload_blob:output_type -> blobs_v1.BlobInfoObjectReply
+	22, // 56: blobs_v1.Blobs.download_blob:output_type -> blobs_v1.BlobDownloadReply
+	24, // 57: blobs_v1.Blobs.get_features:output_type -> blobs_v1.BlobFeaturesReply
+	11, // 58: blobs_v1.Blobs.update_blob_info:output_type -> blobs_v1.BlobInfoObjectReply
+	18, // 59: blobs_v1.Blobs.mark_blobs_completed:output_type -> blobs_v1.BlobEmptyReply
+	18, // 60: blobs_v1.Blobs.delete_blob_by_id:output_type -> blobs_v1.BlobEmptyReply
+	18, // 61: blobs_v1.Blobs.delete_blobs_by_ids:output_type -> blobs_v1.BlobEmptyReply
+	42, // [42:62] is the sub-list for method output_type
+	22, // [22:42] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_protos_blobs_v1_proto_init() }
@@ -1523,7 +2113,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfo); i {
+			switch v := v.(*SortField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,7 +2125,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoPage); i {
+			switch v := v.(*BlobInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,7 +2137,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoPageRequest); i {
+			switch v := v.(*BlobInfoPage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,7 +2149,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoPageReply); i {
+			switch v := v.(*BlobInfoPageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +2161,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobIdsRequest); i {
+			switch v := v.(*BlobInfoPageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,7 +2173,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobIdRequest); i {
+			switch v := v.(*BlobIdsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +2185,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoObjectRequest); i {
+			switch v := v.(*BlobIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +2197,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoObjectsReply); i {
+			switch v := v.(*BlobInfoObjectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,7 +2209,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobInfoObjectReply); i {
+			switch v := v.(*BlobInfoObjectsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1631,7 +2221,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobUriReply); i {
+			switch v := v.(*BlobInfoObjectReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1643,7 +2233,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobTokenRequest); i {
+			switch v := v.(*BlobUriReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1655,7 +2245,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobTokenWithChunkRequest); i {
+			switch v := v.(*BlobTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1667,7 +2257,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobTokenReply); i {
+			switch v := v.(*BlobTokenWithChunkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1679,7 +2269,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobEmptyReply); i {
+			switch v := v.(*BlobPartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1691,7 +2281,7 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlobReadRequest); i {
+			switch v := v.(*BlobTokenReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1703,6 +2293,42 @@ func file_protos_blobs_v1_proto_init() {
 			}
 		}
 		file_protos_blobs_v1_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobOffsetReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobEmptyReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlobChunkReply); i {
 			case 0:
 				return &v.state
@@ -1714,6 +2340,54 @@ func file_protos_blobs_v1_proto_init() {
 				return nil
 			}
 		}
+		file_protos_blobs_v1_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobDownloadReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobFeaturesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protos_blobs_v1_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobFeaturesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1721,7 +2395,7 @@ func file_protos_blobs_v1_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protos_blobs_v1_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
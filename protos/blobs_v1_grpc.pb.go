@@ -28,11 +28,16 @@ type BlobsClient interface {
 	GetBlobUriById(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (*BlobUriReply, error)
 	BeginBlobWrite(ctx context.Context, in *BlobInfoObjectRequest, opts ...grpc.CallOption) (*BlobTokenReply, error)
 	WriteBlobChunk(ctx context.Context, in *BlobTokenWithChunkRequest, opts ...grpc.CallOption) (*BlobTokenReply, error)
+	WriteBlobPart(ctx context.Context, in *BlobPartRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error)
 	EndBlobWrite(ctx context.Context, in *BlobTokenWithChunkRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error)
 	AbortBlobWrite(ctx context.Context, in *BlobTokenRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error)
+	GetBlobWriteOffset(ctx context.Context, in *BlobTokenRequest, opts ...grpc.CallOption) (*BlobOffsetReply, error)
 	BeginBlobRead(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error)
 	ReadBlobChunk(ctx context.Context, in *BlobReadRequest, opts ...grpc.CallOption) (*BlobChunkReply, error)
 	EndBlobRead(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error)
+	UploadBlob(ctx context.Context, opts ...grpc.CallOption) (Blobs_UploadBlobClient, error)
+	DownloadBlob(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (Blobs_DownloadBlobClient, error)
+	GetFeatures(ctx context.Context, in *BlobFeaturesRequest, opts ...grpc.CallOption) (*BlobFeaturesReply, error)
 	UpdateBlobInfo(ctx context.Context, in *BlobInfoObjectRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error)
 	MarkBlobsCompleted(ctx context.Context, in *BlobIdsRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error)
 	DeleteBlobById(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error)
@@ -101,6 +106,15 @@ func (c *blobsClient) WriteBlobChunk(ctx context.Context, in *BlobTokenWithChunk
 	return out, nil
 }
 
+func (c *blobsClient) WriteBlobPart(ctx context.Context, in *BlobPartRequest, opts ...grpc.CallOption) (*BlobEmptyReply, error) {
+	out := new(BlobEmptyReply)
+	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/write_blob_part", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blobsClient) EndBlobWrite(ctx context.Context, in *BlobTokenWithChunkRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error) {
 	out := new(BlobInfoObjectReply)
 	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/end_blob_write", in, out, opts...)
@@ -119,6 +133,15 @@ func (c *blobsClient) AbortBlobWrite(ctx context.Context, in *BlobTokenRequest,
 	return out, nil
 }
 
+func (c *blobsClient) GetBlobWriteOffset(ctx context.Context, in *BlobTokenRequest, opts ...grpc.CallOption) (*BlobOffsetReply, error) {
+	out := new(BlobOffsetReply)
+	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/get_blob_write_offset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blobsClient) BeginBlobRead(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error) {
 	out := new(BlobInfoObjectReply)
 	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/begin_blob_read", in, out, opts...)
@@ -146,6 +169,81 @@ func (c *blobsClient) EndBlobRead(ctx context.Context, in *BlobIdRequest, opts .
 	return out, nil
 }
 
+func (c *blobsClient) UploadBlob(ctx context.Context, opts ...grpc.CallOption) (Blobs_UploadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Blobs_ServiceDesc.Streams[0], "/blobs_v1.Blobs/upload_blob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobsUploadBlobClient{stream}
+	return x, nil
+}
+
+type Blobs_UploadBlobClient interface {
+	Send(*BlobUploadRequest) error
+	CloseAndRecv() (*BlobInfoObjectReply, error)
+	grpc.ClientStream
+}
+
+type blobsUploadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobsUploadBlobClient) Send(m *BlobUploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *blobsUploadBlobClient) CloseAndRecv() (*BlobInfoObjectReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BlobInfoObjectReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blobsClient) DownloadBlob(ctx context.Context, in *BlobIdRequest, opts ...grpc.CallOption) (Blobs_DownloadBlobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Blobs_ServiceDesc.Streams[1], "/blobs_v1.Blobs/download_blob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobsDownloadBlobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Blobs_DownloadBlobClient interface {
+	Recv() (*BlobDownloadReply, error)
+	grpc.ClientStream
+}
+
+type blobsDownloadBlobClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobsDownloadBlobClient) Recv() (*BlobDownloadReply, error) {
+	m := new(BlobDownloadReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blobsClient) GetFeatures(ctx context.Context, in *BlobFeaturesRequest, opts ...grpc.CallOption) (*BlobFeaturesReply, error) {
+	out := new(BlobFeaturesReply)
+	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/get_features", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *blobsClient) UpdateBlobInfo(ctx context.Context, in *BlobInfoObjectRequest, opts ...grpc.CallOption) (*BlobInfoObjectReply, error) {
 	out := new(BlobInfoObjectReply)
 	err := c.cc.Invoke(ctx, "/blobs_v1.Blobs/update_blob_info", in, out, opts...)
@@ -192,11 +290,16 @@ type BlobsServer interface {
 	GetBlobUriById(context.Context, *BlobIdRequest) (*BlobUriReply, error)
 	BeginBlobWrite(context.Context, *BlobInfoObjectRequest) (*BlobTokenReply, error)
 	WriteBlobChunk(context.Context, *BlobTokenWithChunkRequest) (*BlobTokenReply, error)
+	WriteBlobPart(context.Context, *BlobPartRequest) (*BlobEmptyReply, error)
 	EndBlobWrite(context.Context, *BlobTokenWithChunkRequest) (*BlobInfoObjectReply, error)
 	AbortBlobWrite(context.Context, *BlobTokenRequest) (*BlobEmptyReply, error)
+	GetBlobWriteOffset(context.Context, *BlobTokenRequest) (*BlobOffsetReply, error)
 	BeginBlobRead(context.Context, *BlobIdRequest) (*BlobInfoObjectReply, error)
 	ReadBlobChunk(context.Context, *BlobReadRequest) (*BlobChunkReply, error)
 	EndBlobRead(context.Context, *BlobIdRequest) (*BlobEmptyReply, error)
+	UploadBlob(Blobs_UploadBlobServer) error
+	DownloadBlob(*BlobIdRequest, Blobs_DownloadBlobServer) error
+	GetFeatures(context.Context, *BlobFeaturesRequest) (*BlobFeaturesReply, error)
 	UpdateBlobInfo(context.Context, *BlobInfoObjectRequest) (*BlobInfoObjectReply, error)
 	MarkBlobsCompleted(context.Context, *BlobIdsRequest) (*BlobEmptyReply, error)
 	DeleteBlobById(context.Context, *BlobIdRequest) (*BlobEmptyReply, error)
@@ -226,12 +329,18 @@ func (UnimplementedBlobsServer) BeginBlobWrite(context.Context, *BlobInfoObjectR
 func (UnimplementedBlobsServer) WriteBlobChunk(context.Context, *BlobTokenWithChunkRequest) (*BlobTokenReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WriteBlobChunk not implemented")
 }
+func (UnimplementedBlobsServer) WriteBlobPart(context.Context, *BlobPartRequest) (*BlobEmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WriteBlobPart not implemented")
+}
 func (UnimplementedBlobsServer) EndBlobWrite(context.Context, *BlobTokenWithChunkRequest) (*BlobInfoObjectReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndBlobWrite not implemented")
 }
 func (UnimplementedBlobsServer) AbortBlobWrite(context.Context, *BlobTokenRequest) (*BlobEmptyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbortBlobWrite not implemented")
 }
+func (UnimplementedBlobsServer) GetBlobWriteOffset(context.Context, *BlobTokenRequest) (*BlobOffsetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlobWriteOffset not implemented")
+}
 func (UnimplementedBlobsServer) BeginBlobRead(context.Context, *BlobIdRequest) (*BlobInfoObjectReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BeginBlobRead not implemented")
 }
@@ -241,6 +350,15 @@ func (UnimplementedBlobsServer) ReadBlobChunk(context.Context, *BlobReadRequest)
 func (UnimplementedBlobsServer) EndBlobRead(context.Context, *BlobIdRequest) (*BlobEmptyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EndBlobRead not implemented")
 }
+func (UnimplementedBlobsServer) UploadBlob(Blobs_UploadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadBlob not implemented")
+}
+func (UnimplementedBlobsServer) DownloadBlob(*BlobIdRequest, Blobs_DownloadBlobServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadBlob not implemented")
+}
+func (UnimplementedBlobsServer) GetFeatures(context.Context, *BlobFeaturesRequest) (*BlobFeaturesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatures not implemented")
+}
 func (UnimplementedBlobsServer) UpdateBlobInfo(context.Context, *BlobInfoObjectRequest) (*BlobInfoObjectReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlobInfo not implemented")
 }
@@ -374,6 +492,24 @@ func _Blobs_WriteBlobChunk_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blobs_WriteBlobPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobsServer).WriteBlobPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobs_v1.Blobs/write_blob_part",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobsServer).WriteBlobPart(ctx, req.(*BlobPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blobs_EndBlobWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlobTokenWithChunkRequest)
 	if err := dec(in); err != nil {
@@ -410,6 +546,24 @@ func _Blobs_AbortBlobWrite_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blobs_GetBlobWriteOffset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobsServer).GetBlobWriteOffset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobs_v1.Blobs/get_blob_write_offset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobsServer).GetBlobWriteOffset(ctx, req.(*BlobTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blobs_BeginBlobRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlobIdRequest)
 	if err := dec(in); err != nil {
@@ -464,6 +618,71 @@ func _Blobs_EndBlobRead_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Blobs_UploadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BlobsServer).UploadBlob(&blobsUploadBlobServer{stream})
+}
+
+type Blobs_UploadBlobServer interface {
+	SendAndClose(*BlobInfoObjectReply) error
+	Recv() (*BlobUploadRequest, error)
+	grpc.ServerStream
+}
+
+type blobsUploadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobsUploadBlobServer) SendAndClose(m *BlobInfoObjectReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *blobsUploadBlobServer) Recv() (*BlobUploadRequest, error) {
+	m := new(BlobUploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Blobs_DownloadBlob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlobIdRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobsServer).DownloadBlob(m, &blobsDownloadBlobServer{stream})
+}
+
+type Blobs_DownloadBlobServer interface {
+	Send(*BlobDownloadReply) error
+	grpc.ServerStream
+}
+
+type blobsDownloadBlobServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobsDownloadBlobServer) Send(m *BlobDownloadReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Blobs_GetFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobsServer).GetFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blobs_v1.Blobs/get_features",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobsServer).GetFeatures(ctx, req.(*BlobFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Blobs_UpdateBlobInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BlobInfoObjectRequest)
 	if err := dec(in); err != nil {
@@ -567,6 +786,10 @@ var Blobs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "write_blob_chunk",
 			Handler:    _Blobs_WriteBlobChunk_Handler,
 		},
+		{
+			MethodName: "write_blob_part",
+			Handler:    _Blobs_WriteBlobPart_Handler,
+		},
 		{
 			MethodName: "end_blob_write",
 			Handler:    _Blobs_EndBlobWrite_Handler,
@@ -575,6 +798,10 @@ var Blobs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "abort_blob_write",
 			Handler:    _Blobs_AbortBlobWrite_Handler,
 		},
+		{
+			MethodName: "get_blob_write_offset",
+			Handler:    _Blobs_GetBlobWriteOffset_Handler,
+		},
 		{
 			MethodName: "begin_blob_read",
 			Handler:    _Blobs_BeginBlobRead_Handler,
@@ -587,6 +814,10 @@ var Blobs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "end_blob_read",
 			Handler:    _Blobs_EndBlobRead_Handler,
 		},
+		{
+			MethodName: "get_features",
+			Handler:    _Blobs_GetFeatures_Handler,
+		},
 		{
 			MethodName: "update_blob_info",
 			Handler:    _Blobs_UpdateBlobInfo_Handler,
@@ -604,6 +835,17 @@ var Blobs_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Blobs_DeleteBlobsByIds_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "upload_blob",
+			Handler:       _Blobs_UploadBlob_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "download_blob",
+			Handler:       _Blobs_DownloadBlob_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "protos/blobs_v1.proto",
 }
@@ -0,0 +1,119 @@
+package test_version1
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/build"
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cref "github.com/pip-services3-gox/pip-services3-commons-gox/refer"
+	"github.com/stretchr/testify/assert"
+)
+
+type blobsAutoClientV1Test struct {
+	service *BlobsGrpcMockServiceV1
+	client  *version1.BlobsAutoClientV1
+	fixture *BlobsClientFixtureV1
+}
+
+func newBlobsAutoClientV1Test() *blobsAutoClientV1Test {
+	return &blobsAutoClientV1Test{
+		service: NewBlobsGrpcMockServiceV1(true),
+	}
+}
+
+func (c *blobsAutoClientV1Test) setup(t *testing.T, transports string) {
+	err := c.service.Open()
+	assert.Nil(t, err)
+
+	c.client = version1.NewBlobsAutoClientV1()
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"connection.protocol", "http",
+		"connection.host", "127.0.0.1",
+		"connection.port", c.service.Port,
+		"options.transports", transports,
+		"options.probe_timeout", 1000,
+		"options.chunk_size", 5,
+	))
+	err = c.client.Open(context.Background(), "")
+	assert.Nil(t, err)
+
+	c.fixture = NewBlobsClientFixtureV1(c.client)
+}
+
+func (c *blobsAutoClientV1Test) teardown(t *testing.T) {
+	c.client.Close(context.Background(), "")
+	c.service.Close()
+}
+
+func TestAutoSelectsNativeGrpc(t *testing.T) {
+	c := newBlobsAutoClientV1Test()
+	c.setup(t, "")
+	defer c.teardown(t)
+
+	assert.Equal(t, version1.BlobsTransportGrpc, c.client.Transport())
+	c.fixture.TestReadWriteData(t)
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestAutoSkipsUnavailableTransports(t *testing.T) {
+	c := newBlobsAutoClientV1Test()
+	c.setup(t, "commandable-grpc,commandable-http,grpc")
+	defer c.teardown(t)
+
+	assert.Equal(t, version1.BlobsTransportGrpc, c.client.Transport())
+	c.fixture.TestReadWriteChunks(t)
+}
+
+func TestAutoKeepsGrpcWithoutStreaming(t *testing.T) {
+	c := newBlobsAutoClientV1Test()
+	c.service = NewBlobsGrpcMockServiceV1(false)
+	c.setup(t, "grpc,commandable-grpc")
+	defer c.teardown(t)
+
+	// Commandable gRPC does not respond, so chunky native gRPC is the fallback
+	assert.Equal(t, version1.BlobsTransportGrpc, c.client.Transport())
+	c.fixture.TestReadWriteChunks(t)
+}
+
+func TestAutoFailsWithoutService(t *testing.T) {
+	// Take a free port and release it, so nothing listens there
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	client := version1.NewBlobsAutoClientV1()
+	client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"connection.protocol", "http",
+		"connection.host", "127.0.0.1",
+		"connection.port", strconv.Itoa(port),
+		"options.probe_timeout", 1000,
+	))
+
+	err = client.Open(context.Background(), "")
+	assert.NotNil(t, err)
+	assert.False(t, client.IsOpen())
+
+	_, err = client.GetBlobById(context.Background(), "", "1")
+	assert.NotNil(t, err)
+}
+
+func TestAutoFactoryDescriptors(t *testing.T) {
+	factory := build.NewBlobsClientFactory()
+
+	client, err := factory.Create(cref.NewDescriptor("service-blobs", "client", "grpc", "default", "1.0"))
+	assert.Nil(t, err)
+	assert.IsType(t, &version1.BlobGrpcClientV1{}, client)
+
+	client, err = factory.Create(cref.NewDescriptor("service-blobs", "client", "auto", "default", "1.0"))
+	assert.Nil(t, err)
+	assert.IsType(t, &version1.BlobsAutoClientV1{}, client)
+
+	client, err = factory.Create(cref.NewDescriptor("service-blobs", "client", "file", "default", "1.0"))
+	assert.Nil(t, err)
+	assert.IsType(t, &version1.BlobsFileClientV1{}, client)
+}
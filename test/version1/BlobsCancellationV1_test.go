@@ -0,0 +1,206 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// cancellingBlobsClient delays every chunk, cancels the transfer after the given number of chunks
+// and records whether the transfer was released with a live context
+type cancellingBlobsClient struct {
+	*version1.BlobsMockClientV1
+	cancel   context.CancelFunc
+	cancelAt int
+	lock     sync.Mutex
+	chunks   int
+	aborted  int
+	ended    int
+	deadCtx  int
+}
+
+func newCancellingBlobsClient(cancelAt int) *cancellingBlobsClient {
+	return &cancellingBlobsClient{
+		BlobsMockClientV1: version1.NewBlobsMockClientV1(),
+		cancelAt:          cancelAt,
+	}
+}
+
+func (c *cancellingBlobsClient) chunk() {
+	// Chunk operations ignore the context, so only the processor can stop the transfer
+	time.Sleep(10 * time.Millisecond)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.chunks++
+	if c.cancel != nil && c.chunks == c.cancelAt {
+		c.cancel()
+	}
+}
+
+func (c *cancellingBlobsClient) release(ctx context.Context, counter *int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	*counter++
+	if ctx.Err() != nil {
+		c.deadCtx++
+	}
+}
+
+// counts returns the recorded calls, reads ahead may still run after the transfer was cancelled
+func (c *cancellingBlobsClient) counts() (chunks int, released int, deadCtx int) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.chunks, c.aborted + c.ended, c.deadCtx
+}
+
+func (c *cancellingBlobsClient) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (string, error) {
+	c.chunk()
+	return c.BlobsMockClientV1.WriteBlobChunk(context.Background(), correlationId, token, chunk)
+}
+
+func (c *cancellingBlobsClient) ReadBlobChunk(ctx context.Context, correlationId string, blobId string,
+	skip int64, take int64) ([]byte, error) {
+	c.chunk()
+	return c.BlobsMockClientV1.ReadBlobChunk(context.Background(), correlationId, blobId, skip, take)
+}
+
+func (c *cancellingBlobsClient) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
+	c.release(ctx, &c.aborted)
+	return c.BlobsMockClientV1.AbortBlobWrite(ctx, correlationId, token)
+}
+
+func (c *cancellingBlobsClient) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
+	c.release(ctx, &c.ended)
+	return c.BlobsMockClientV1.EndBlobRead(ctx, correlationId, blobId)
+}
+
+func assertTransferCancelled(t *testing.T, err error, cause error) {
+	assert.True(t, errors.Is(err, cause), "unexpected error %v", err)
+
+	var cancelled *version1.BlobsTransferCancelledErrorV1
+	if assert.True(t, errors.As(err, &cancelled)) {
+		assert.Equal(t, "123", cancelled.CorrelationId)
+		assert.NotEmpty(t, cancelled.BlobId)
+	}
+
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, version1.BlobTransferCancelled, appErr.Code)
+	}
+}
+
+func TestCancelUpload(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 100)
+
+	tests := []struct {
+		name   string
+		upload func(ctx context.Context, client *cancellingBlobsClient) (*version1.BlobInfoV1, error)
+	}{
+		{"data", func(ctx context.Context, client *cancellingBlobsClient) (*version1.BlobInfoV1, error) {
+			return version1.BlobsDataProcessorV1.CreateBlobFromData(ctx, "123",
+				version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), client, content, 10)
+		}},
+		{"stream", func(ctx context.Context, client *cancellingBlobsClient) (*version1.BlobInfoV1, error) {
+			return version1.BlobsStreamProcessorV1.CreateBlobFromStream(ctx, "123",
+				version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), client, bytes.NewReader(content), 10)
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newCancellingBlobsClient(3)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client.cancel = cancel
+
+			blob, err := test.upload(ctx, client)
+			assert.Nil(t, blob)
+			assertTransferCancelled(t, err, context.Canceled)
+
+			// Nothing is sent after cancellation and the write is aborted
+			chunks, released, deadCtx := client.counts()
+			assert.Equal(t, 3, chunks)
+			assert.Equal(t, 1, released)
+			assert.Equal(t, 0, deadCtx)
+		})
+	}
+}
+
+func TestCancelDownload(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 100)
+
+	tests := []struct {
+		name     string
+		download func(ctx context.Context, client *cancellingBlobsClient) error
+	}{
+		{"data", func(ctx context.Context, client *cancellingBlobsClient) error {
+			_, _, err := version1.BlobsDataProcessorV1.GetBlobDataById(ctx, "123", "1", client, 10)
+			return err
+		}},
+		{"stream", func(ctx context.Context, client *cancellingBlobsClient) error {
+			_, err := version1.BlobsStreamProcessorV1.GetBlobStreamById(ctx, "123", "1", client, &bytes.Buffer{}, 10)
+			return err
+		}},
+		{"range", func(ctx context.Context, client *cancellingBlobsClient) error {
+			_, err := version1.BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, "123", "1", 50, -1,
+				client, &bytes.Buffer{}, version1.NewBlobsTransferOptionsV1WithChunkSize(10))
+			return err
+		}},
+		{"read ahead", func(ctx context.Context, client *cancellingBlobsClient) error {
+			options := version1.NewBlobsTransferOptionsV1WithChunkSize(10)
+			options.ReadAhead = 2
+			_, err := version1.BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(ctx, "123", "1", client,
+				&bytes.Buffer{}, options)
+			return err
+		}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := newCancellingBlobsClient(3)
+			_, err := version1.BlobsDataProcessorV1.CreateBlobFromData(context.Background(), "123",
+				version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), client.BlobsMockClientV1, content, 1000)
+			assert.Nil(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client.cancel = cancel
+
+			err = test.download(ctx, client)
+			assertTransferCancelled(t, err, context.Canceled)
+
+			// The read is released on the server
+			chunks, released, deadCtx := client.counts()
+			assert.Less(t, chunks, 10)
+			assert.Equal(t, 1, released)
+			assert.Equal(t, 0, deadCtx)
+		})
+	}
+}
+
+func TestCancelByDeadline(t *testing.T) {
+	client := newCancellingBlobsClient(0)
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := version1.BlobsStreamProcessorV1.CreateBlobFromStream(ctx, "123",
+		version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), client, bytes.NewReader(content), 10)
+	assertTransferCancelled(t, err, context.DeadlineExceeded)
+
+	// The upload stops right after the deadline instead of sending all 1000 chunks
+	assert.Less(t, time.Since(start), time.Second)
+	_, released, deadCtx := client.counts()
+	assert.Equal(t, 1, released)
+	assert.Equal(t, 0, deadCtx)
+}
@@ -0,0 +1,126 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// slowBlobsClient records sizes of chunks and delays chunks larger than slowSize
+type slowBlobsClient struct {
+	*version1.BlobsMockClientV1
+	slowSize int
+	lock     sync.Mutex
+	writes   []int
+	reads    []int
+}
+
+func newSlowBlobsClient(slowSize int) *slowBlobsClient {
+	return &slowBlobsClient{
+		BlobsMockClientV1: version1.NewBlobsMockClientV1(),
+		slowSize:          slowSize,
+	}
+}
+
+func (c *slowBlobsClient) delay(size int) {
+	if c.slowSize > 0 && size > c.slowSize {
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func (c *slowBlobsClient) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (string, error) {
+	c.lock.Lock()
+	c.writes = append(c.writes, len(chunk))
+	c.lock.Unlock()
+	c.delay(len(chunk))
+	return c.BlobsMockClientV1.WriteBlobChunk(ctx, correlationId, token, chunk)
+}
+
+func (c *slowBlobsClient) ReadBlobChunk(ctx context.Context, correlationId string, blobId string,
+	skip int64, take int64) ([]byte, error) {
+	c.lock.Lock()
+	c.reads = append(c.reads, int(take))
+	c.lock.Unlock()
+	c.delay(int(take))
+	return c.BlobsMockClientV1.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+}
+
+func newAdaptiveOptions(params ...any) *version1.BlobsTransferOptionsV1 {
+	options := version1.NewBlobsTransferOptionsV1()
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(append([]any{
+		"options.adaptive_chunks", true,
+		"options.min_chunk_size", 100,
+		"options.max_chunk_size", 1600,
+		"options.chunk_rtt", 40,
+	}, params...)...))
+	return options
+}
+
+func TestAdaptiveChunksGrow(t *testing.T) {
+	client := newSlowBlobsClient(0)
+	options := newAdaptiveOptions()
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+
+	blob, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "adaptive.dat", 0, ""), client, bytes.NewReader(content), options)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{100, 200, 400, 800, 1600, 1600, 1600, 1600, 1600, 500}, client.writes)
+
+	result, _, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "", blob.Id,
+		client, options)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+	assert.Equal(t, []int{100, 200, 400, 800, 1600}, client.reads[:5])
+
+	// Fixed chunks without adaptive mode
+	client.reads = nil
+	_, _, err = version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "", blob.Id,
+		client, version1.NewBlobsTransferOptionsV1WithChunkSize(3000))
+	assert.Nil(t, err)
+	assert.Equal(t, []int{3000, 3000, 3000, 1000}, client.reads)
+}
+
+func TestAdaptiveChunksShrink(t *testing.T) {
+	client := newSlowBlobsClient(400)
+	options := newAdaptiveOptions()
+	content := bytes.Repeat([]byte("0123456789"), 1000)
+
+	blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "adaptive.dat", 0, ""), client, content, options)
+	assert.Nil(t, err)
+
+	// Slow chunks are halved
+	assert.Equal(t, []int{100, 200, 400, 800, 400, 800, 400}, client.writes[:7])
+	for _, size := range client.writes {
+		assert.LessOrEqual(t, size, 800)
+	}
+
+	buffer := &bytes.Buffer{}
+	_, err = version1.BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(context.Background(), "", blob.Id,
+		client, buffer, options)
+	assert.Nil(t, err)
+	assert.Equal(t, content, buffer.Bytes())
+	assert.Equal(t, []int{100, 200, 400, 800, 400}, client.reads[:5])
+}
+
+func TestAdaptiveChunksMessageSize(t *testing.T) {
+	client := newSlowBlobsClient(0)
+	options := newAdaptiveOptions("options.max_message_size", 2000)
+	content := bytes.Repeat([]byte("0123456789"), 500)
+
+	_, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "adaptive.dat", 0, ""), client, content, options)
+	assert.Nil(t, err)
+
+	// Room is kept for base64 encoding and the envelope
+	for _, size := range client.writes {
+		assert.LessOrEqual(t, size, 2000*3/4-1024)
+	}
+	assert.Contains(t, client.writes, 2000*3/4-1024)
+}
@@ -1,12 +1,19 @@
 package test_version1
 
 import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
+	"io"
 	"io/ioutil"
 	"os"
+	"sync"
 	"testing"
 
 	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
 
 	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
 	"github.com/stretchr/testify/assert"
@@ -192,6 +199,38 @@ func (c *BlobsClientFixtureV1) TestReadWriteStream(t *testing.T) {
 	assert.Equal(t, sample, sample1)
 }
 
+func (c *BlobsClientFixtureV1) TestEmptyBlob(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	// Empty data
+	blob, err := c.Client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "empty1.dat", 0, "application/binary"), []byte{})
+	assert.Nil(t, err)
+	if assert.NotNil(t, blob) {
+		assert.Equal(t, int64(0), blob.Size)
+
+		result, info, err := c.Client.GetBlobDataById(context.Background(), "", blob.Id)
+		assert.Nil(t, err)
+		assert.Len(t, result, 0)
+		if assert.NotNil(t, info) {
+			assert.Equal(t, int64(0), info.Size)
+		}
+	}
+
+	// Empty stream
+	blob, err = c.Client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "empty2.dat", 0, "application/binary"), bytes.NewReader([]byte{}))
+	assert.Nil(t, err)
+	if assert.NotNil(t, blob) {
+		buffer := &bytes.Buffer{}
+		info, err := c.Client.ReadBlobStreamById(context.Background(), "", blob.Id, buffer)
+		assert.Nil(t, err)
+		assert.NotNil(t, info)
+		assert.Equal(t, 0, buffer.Len())
+	}
+}
+
 func (c *BlobsClientFixtureV1) TestWritingBlobUri(t *testing.T) {
 	c.clear()
 	defer c.clear()
@@ -229,3 +268,608 @@ func (c *BlobsClientFixtureV1) TestGetUriForMissingBlob(t *testing.T) {
 	assert.Equal(t, "", uri)
 	assert.Nil(t, err)
 }
+
+type failingReader struct {
+	reader io.Reader
+	limit  int
+}
+
+func (c *failingReader) Read(p []byte) (int, error) {
+	if c.limit <= 0 {
+		return 0, errors.New("connection lost")
+	}
+	if len(p) > c.limit {
+		p = p[:c.limit]
+	}
+	n, err := c.reader.Read(p)
+	c.limit -= n
+	return n, err
+}
+
+func (c *failingReader) Seek(offset int64, whence int) (int64, error) {
+	return c.reader.(io.Seeker).Seek(offset, whence)
+}
+
+func (c *BlobsClientFixtureV1) TestResumeStream(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	client := c.Client.(interface {
+		CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string,
+			blob *version1.BlobInfoV1, stream io.ReadSeeker) (*version1.BlobInfoV1, error)
+	})
+
+	blobId := data.IdGenerator.NextLong()
+	key := "upload-" + blobId
+	sample := make([]byte, 30000)
+	for i := range sample {
+		sample[i] = byte(i % 251)
+	}
+
+	// Fail in the middle of the upload
+	blob := version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".dat", 0, "application/binary")
+	stream := &failingReader{reader: bytes.NewReader(sample), limit: 15000}
+
+	_, err := client.CreateBlobFromStreamResumable(context.Background(), "", key, blob, stream)
+	assert.NotNil(t, err)
+
+	// Resume with a new stream
+	blob = version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".dat", 0, "application/binary")
+
+	blob, err = client.CreateBlobFromStreamResumable(context.Background(), "", key, blob, bytes.NewReader(sample))
+	assert.Nil(t, err)
+	assert.NotNil(t, blob)
+	assert.Equal(t, int64(len(sample)), blob.Size)
+
+	// Read blob
+	buffer, _, err := c.Client.GetBlobDataById(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Equal(t, sample, buffer)
+}
+
+func (c *BlobsClientFixtureV1) TestContentChecksum(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	blobId := data.IdGenerator.NextLong()
+	blob := version1.NewBlobInfoV1(
+		blobId, "test", "file-"+blobId+".dat", 0, "application/binary",
+	)
+	content := []byte("Content with a digest")
+
+	// Checksum is calculated on upload
+	blob1, err := c.Client.CreateBlobFromStream(context.Background(), "", blob, bytes.NewReader(content))
+	assert.Nil(t, err)
+	assert.NotNil(t, blob1)
+	assert.Equal(t, version1.ChecksumSha256, blob1.ChecksumAlgorithm)
+	assert.Equal(t, version1.ComputeBlobChecksumV1(version1.ChecksumSha256, content), blob1.Checksum)
+
+	// Content matches the checksum
+	result, _, err := c.Client.GetBlobDataById(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+
+	// Damaged checksum is detected on read
+	blob1.Checksum = version1.ComputeBlobChecksumV1(version1.ChecksumSha256, []byte("Other content"))
+	_, err = c.Client.UpdateBlobInfo(context.Background(), "", blob1)
+	assert.Nil(t, err)
+
+	_, _, err = c.Client.GetBlobDataById(context.Background(), "", blobId)
+	var integrityErr *version1.BlobsIntegrityErrorV1
+	assert.True(t, errors.As(err, &integrityErr))
+
+	var buffer bytes.Buffer
+	_, err = c.Client.ReadBlobStreamById(context.Background(), "", blobId, &buffer)
+	assert.True(t, errors.As(err, &integrityErr))
+}
+
+func (c *BlobsClientFixtureV1) TestMetadataAndTags(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	blob1 := version1.NewBlobInfoV1("", "test", "file1.dat", 0, "application/binary")
+	blob1.Metadata = map[string]string{"owner": "alice", "project": "apollo"}
+	blob1.Tags = []string{"report", "draft"}
+
+	blob2 := version1.NewBlobInfoV1("", "test", "file2.dat", 0, "application/binary")
+	blob2.Metadata = map[string]string{"owner": "bob", "project": "apollo"}
+	blob2.Tags = []string{"report"}
+
+	// Create blobs
+	result, err := c.Client.CreateBlobFromData(context.Background(), "", blob1, []byte{1, 2, 3})
+	assert.Nil(t, err)
+	blob1 = result
+
+	result, err = c.Client.CreateBlobFromData(context.Background(), "", blob2, []byte{4, 5, 6})
+	assert.Nil(t, err)
+	blob2 = result
+
+	// Custom data is kept
+	result, err = c.Client.GetBlobById(context.Background(), "", blob1.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"owner": "alice", "project": "apollo"}, result.Metadata)
+	assert.Equal(t, []string{"report", "draft"}, result.Tags)
+
+	// Filter by tag
+	page, err := c.Client.GetBlobsByFilter(context.Background(), "",
+		data.NewFilterParamsFromTuples("tag", "draft"), nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 1)
+	assert.Equal(t, blob1.Id, page.Data[0].Id)
+
+	page, err = c.Client.GetBlobsByFilter(context.Background(), "",
+		data.NewFilterParamsFromTuples("tag", "report"), nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 2)
+
+	// Filter by metadata
+	page, err = c.Client.GetBlobsByFilter(context.Background(), "",
+		data.NewFilterParamsFromTuples("meta.owner", "bob", "meta.project", "apollo"), nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 1)
+	assert.Equal(t, blob2.Id, page.Data[0].Id)
+
+	page, err = c.Client.GetBlobsByFilter(context.Background(), "",
+		data.NewFilterParamsFromTuples("meta.owner", "carol"), nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 0)
+}
+
+func (c *BlobsClientFixtureV1) TestBlobReader(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	client := c.Client.(interface {
+		OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*version1.BlobsReaderV1, error)
+	})
+
+	// Pack a few files into zip archive
+	archive := &bytes.Buffer{}
+	zipWriter := zip.NewWriter(archive)
+	files := map[string]string{
+		"first.txt":  "The first file content",
+		"second.txt": "The second file content that is a bit longer",
+	}
+	for name, content := range files {
+		w, err := zipWriter.Create(name)
+		assert.Nil(t, err)
+		_, err = w.Write([]byte(content))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, zipWriter.Close())
+
+	blobId := data.IdGenerator.NextLong()
+	blob := version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".zip", 0, "application/zip")
+	_, err := c.Client.CreateBlobFromData(context.Background(), "", blob, archive.Bytes())
+	assert.Nil(t, err)
+
+	reader, err := client.OpenBlobReader(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(archive.Len()), reader.Size())
+
+	// Read sequentially
+	content, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, archive.Bytes(), content)
+
+	// Read from the end
+	position, err := reader.Seek(-10, io.SeekEnd)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(archive.Len()-10), position)
+	content, err = io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, archive.Bytes()[archive.Len()-10:], content)
+
+	// Random access
+	buffer := make([]byte, 7)
+	n, err := reader.ReadAt(buffer, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, 7, n)
+	assert.Equal(t, archive.Bytes()[3:10], buffer)
+
+	n, err = reader.ReadAt(buffer, int64(archive.Len()-3))
+	assert.Equal(t, io.EOF, err)
+	assert.Equal(t, 3, n)
+
+	// Open archive directly from the blob
+	zipReader, err := zip.NewReader(reader, reader.Size())
+	assert.Nil(t, err)
+	assert.Len(t, zipReader.File, len(files))
+	for _, file := range zipReader.File {
+		r, err := file.Open()
+		assert.Nil(t, err)
+		content, err := io.ReadAll(r)
+		assert.Nil(t, err)
+		r.Close()
+		assert.Equal(t, files[file.Name], string(content))
+	}
+
+	assert.Nil(t, reader.Close())
+	_, err = reader.Read(buffer)
+	assert.NotNil(t, err)
+}
+
+func (c *BlobsClientFixtureV1) TestBlobWriter(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	client := c.Client.(interface {
+		OpenBlobWriter(ctx context.Context, correlationId string, blob *version1.BlobInfoV1) (*version1.BlobsWriterV1, error)
+	})
+
+	// Compress content on the fly
+	blobId := data.IdGenerator.NextLong()
+	blob := version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".gz", 0, "application/gzip")
+	writer, err := client.OpenBlobWriter(context.Background(), "", blob)
+	assert.Nil(t, err)
+
+	content := bytes.Repeat([]byte("Line of the compressed content\n"), 500)
+	gzipWriter := gzip.NewWriter(writer)
+	_, err = gzipWriter.Write(content)
+	assert.Nil(t, err)
+	assert.Nil(t, gzipWriter.Close())
+	assert.Nil(t, writer.Close())
+
+	blob = writer.Blob()
+	assert.NotNil(t, blob)
+	assert.Equal(t, blobId, blob.Id)
+	assert.True(t, blob.Size > 0)
+
+	compressed, _, err := c.Client.GetBlobDataById(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Equal(t, blob.Size, int64(len(compressed)))
+	gzipReader, err := gzip.NewReader(bytes.NewReader(compressed))
+	assert.Nil(t, err)
+	result, err := io.ReadAll(gzipReader)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+
+	// Abort on error
+	blobId = data.IdGenerator.NextLong()
+	blob = version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".dat", 0, "application/binary")
+	writer, err = client.OpenBlobWriter(context.Background(), "", blob)
+	assert.Nil(t, err)
+	_, err = writer.Write(content)
+	assert.Nil(t, err)
+
+	failure := errors.New("producer failed")
+	writer.CloseWithError(failure)
+	_, err = writer.Write(content)
+	assert.Equal(t, failure, err)
+
+	blob, err = c.Client.GetBlobById(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Nil(t, blob)
+
+	// Abort on cancellation
+	ctx, cancel := context.WithCancel(context.Background())
+	blobId = data.IdGenerator.NextLong()
+	blob = version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".dat", 0, "application/binary")
+	writer, err = client.OpenBlobWriter(ctx, "", blob)
+	assert.Nil(t, err)
+	_, err = writer.Write(content[:100])
+	assert.Nil(t, err)
+
+	cancel()
+	_, err = writer.Write(content)
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, context.Canceled, writer.Close())
+
+	blob, err = c.Client.GetBlobById(context.Background(), "", blobId)
+	assert.Nil(t, err)
+	assert.Nil(t, blob)
+}
+
+func (c *BlobsClientFixtureV1) TestReadBlobRange(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	client := c.Client.(version1.IBlobsRangeReaderV1)
+
+	blobId := data.IdGenerator.NextLong()
+	blob := version1.NewBlobInfoV1(blobId, "test", "file-"+blobId+".dat", 0, "application/binary")
+	content := []byte("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+	_, err := c.Client.CreateBlobFromData(context.Background(), "", blob, content)
+	assert.Nil(t, err)
+
+	// Read the middle
+	result, blob, err := client.GetBlobRangeById(context.Background(), "", blobId, 5, 20)
+	assert.Nil(t, err)
+	assert.NotNil(t, blob)
+	assert.Equal(t, content[5:25], result)
+
+	// Read till the end
+	result, _, err = client.GetBlobRangeById(context.Background(), "", blobId, 30, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, content[30:], result)
+
+	// Range longer than the blob is cut
+	var buffer bytes.Buffer
+	_, err = client.ReadBlobRangeStreamById(context.Background(), "", blobId, 10, 100, &buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, content[10:], buffer.Bytes())
+
+	// Empty range
+	result, _, err = client.GetBlobRangeById(context.Background(), "", blobId, int64(len(content)), 10)
+	assert.Nil(t, err)
+	assert.Len(t, result, 0)
+
+	// Offset outside of the blob
+	_, _, err = client.GetBlobRangeById(context.Background(), "", blobId, int64(len(content))+1, 10)
+	assert.NotNil(t, err)
+}
+
+func (c *BlobsClientFixtureV1) TestSortBlobs(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	sizes := map[string]int{"small.dat": 3, "large.dat": 12, "medium.dat": 7}
+	for _, name := range []string{"small.dat", "large.dat", "medium.dat"} {
+		_, err := c.Client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", name, 0, "application/binary"), make([]byte, sizes[name]))
+		assert.Nil(t, err)
+	}
+
+	// Largest blobs first
+	sorted := c.Client.(version1.IBlobsSortedReaderV1)
+	page, err := sorted.GetBlobsByFilterWithSort(context.Background(), "",
+		data.NewFilterParamsFromTuples("group", "test"), data.NewPagingParams(0, 2, true),
+		data.NewSortParams([]data.SortField{data.NewSortField("size", false)}))
+	assert.Nil(t, err)
+	assert.Equal(t, 3, page.Total)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, "large.dat", page.Data[0].Name)
+	assert.Equal(t, "medium.dat", page.Data[1].Name)
+
+	// Newest blobs first
+	page, err = sorted.GetBlobsByFilterWithSort(context.Background(), "",
+		nil, nil, data.NewSortParams([]data.SortField{data.NewSortField("create_time", false)}))
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 3)
+	assert.Equal(t, "medium.dat", page.Data[0].Name)
+	assert.Equal(t, "small.dat", page.Data[2].Name)
+}
+
+func (c *BlobsClientFixtureV1) TestListBlobs(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	names := []string{"file1.dat", "file2.dat", "file3.dat", "file4.dat", "file5.dat"}
+	for _, name := range names {
+		_, err := c.Client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", name, 0, "application/binary"), []byte(name))
+		assert.Nil(t, err)
+	}
+
+	// Pages are smaller than the number of blobs, blobs come by create time
+	options := version1.NewBlobsTransferOptionsV1()
+	options.PageSize = 2
+	iterator := version1.NewBlobsIteratorV1(context.Background(), "", c.Client,
+		data.NewFilterParamsFromTuples("group", "test"), options)
+	defer iterator.Close()
+
+	result := make([]string, 0)
+	for iterator.Next() {
+		result = append(result, iterator.Blob().Name)
+	}
+	assert.Nil(t, iterator.Err())
+	assert.Equal(t, names, result)
+	assert.Nil(t, iterator.Blob())
+
+	// Empty result
+	iterator = version1.NewBlobsIteratorV1(context.Background(), "", c.Client,
+		data.NewFilterParamsFromTuples("group", "missing"), options)
+	assert.False(t, iterator.Next())
+	assert.Nil(t, iterator.Err())
+	iterator.Close()
+}
+
+// TestWriteBlobParts expects the client to write parts and report the committed offset
+func (c *BlobsClientFixtureV1) TestWriteBlobParts(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	client := c.Client.(interface {
+		version1.IBlobsChunkyWriterV1
+		version1.IBlobsChunkyPartWriterV1
+		version1.IBlobsResumableWriterV1
+	})
+	ctx := context.Background()
+
+	token, err := client.BeginBlobWrite(ctx, "", version1.NewBlobInfoV1("", "test", "file.dat", 0, ""))
+	assert.Nil(t, err)
+
+	// Negative offsets are rejected
+	err = client.WriteBlobPart(ctx, "", token, -1, []byte("abc"))
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, cerr.BadRequest, appErr.Category)
+	}
+
+	// Only the contiguous prefix is committed
+	assert.Nil(t, client.WriteBlobPart(ctx, "", token, 6, []byte("ghi")))
+	assert.Nil(t, client.WriteBlobPart(ctx, "", token, 0, []byte("abc")))
+	offset, _, err := client.GetBlobWriteOffset(ctx, "", token)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), offset)
+
+	// The write cannot end with a gap
+	_, err = client.EndBlobWrite(ctx, "", token, nil)
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "BLOB_INCOMPLETE", appErr.Code)
+	}
+
+	// Filling the gap commits the parts after it
+	assert.Nil(t, client.WriteBlobPart(ctx, "", token, 3, []byte("def")))
+	offset, _, err = client.GetBlobWriteOffset(ctx, "", token)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(9), offset)
+
+	blob, err := client.EndBlobWrite(ctx, "", token, []byte("jk"))
+	assert.Nil(t, err)
+	assert.Equal(t, int64(11), blob.Size)
+
+	result, _, err := c.Client.GetBlobDataById(ctx, "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "abcdefghijk", string(result))
+}
+
+// TestCompressedContent expects the client configured with gzip content encoding
+func (c *BlobsClientFixtureV1) TestCompressedContent(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	content := bytes.Repeat([]byte("{\"level\":\"info\",\"message\":\"Blob was compressed\"}\n"), 200)
+
+	// Compress data
+	blob, err := c.Client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "log1.json", 0, "application/json"), content)
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ContentEncodingGzip, blob.ContentEncoding)
+	assert.True(t, blob.Size < int64(len(content)))
+
+	result, info, err := c.Client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ContentEncodingGzip, info.ContentEncoding)
+	assert.Equal(t, content, result)
+
+	buffer := &bytes.Buffer{}
+	_, err = c.Client.ReadBlobStreamById(context.Background(), "", blob.Id, buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, content, buffer.Bytes())
+
+	// Ranges are taken from decompressed content
+	rangeReader := c.Client.(version1.IBlobsRangeReaderV1)
+	result, _, err = rangeReader.GetBlobRangeById(context.Background(), "", blob.Id, 100, 50)
+	assert.Nil(t, err)
+	assert.Equal(t, content[100:150], result)
+
+	_, _, err = rangeReader.GetBlobRangeById(context.Background(), "", blob.Id, int64(len(content))+1, 10)
+	assert.NotNil(t, err)
+
+	// Compressed content has no random access
+	reader, err := c.Client.(interface {
+		OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*version1.BlobsReaderV1, error)
+	}).OpenBlobReader(context.Background(), "", blob.Id)
+	assert.Nil(t, reader)
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "UNSUPPORTED_ENCODING", appErr.Code)
+	}
+
+	// Compress stream
+	blob, err = c.Client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "log2.json", 0, "application/json"), bytes.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ContentEncodingGzip, blob.ContentEncoding)
+	assert.True(t, blob.Size < int64(len(content)))
+
+	result, _, err = c.Client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+
+	// Compress written content
+	client := c.Client.(interface {
+		OpenBlobWriter(ctx context.Context, correlationId string, blob *version1.BlobInfoV1) (*version1.BlobsWriterV1, error)
+	})
+	writer, err := client.OpenBlobWriter(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "log3.json", 0, "application/json"))
+	assert.Nil(t, err)
+	for i := 0; i < len(content); i += 1000 {
+		end := i + 1000
+		if end > len(content) {
+			end = len(content)
+		}
+		_, err = writer.Write(content[i:end])
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, writer.Close())
+	assert.Equal(t, version1.ContentEncodingGzip, writer.Blob().ContentEncoding)
+
+	buffer.Reset()
+	_, err = c.Client.ReadBlobStreamById(context.Background(), "", writer.Blob().Id, buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, content, buffer.Bytes())
+}
+
+type progressRecorder struct {
+	lock   sync.Mutex
+	events []version1.BlobsProgressV1
+}
+
+func (c *progressRecorder) OnBlobProgress(ctx context.Context, correlationId string, progress version1.BlobsProgressV1) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.events = append(c.events, progress)
+}
+
+func (c *progressRecorder) reset() []version1.BlobsProgressV1 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	events := c.events
+	c.events = nil
+	return events
+}
+
+func assertTransferProgress(t *testing.T, operation string, size int64, events []version1.BlobsProgressV1) {
+	if !assert.True(t, len(events) >= 2) {
+		return
+	}
+
+	bytes := int64(0)
+	for i, event := range events {
+		assert.Equal(t, operation, event.Operation)
+		assert.True(t, event.Bytes >= bytes)
+		assert.Equal(t, i == len(events)-1, event.Done)
+		bytes = event.Bytes
+	}
+
+	last := events[len(events)-1]
+	assert.Equal(t, size, last.Bytes)
+	assert.Equal(t, size, last.Total)
+	assert.NotEmpty(t, last.BlobId)
+	assert.Equal(t, len(events)-1, last.Chunk)
+}
+
+func (c *BlobsClientFixtureV1) TestTransferProgress(t *testing.T) {
+	c.clear()
+	defer c.clear()
+
+	content := bytes.Repeat([]byte("0123456789"), 200)
+	recorder := &progressRecorder{}
+	ctx := version1.WithBlobsProgressObserverV1(context.Background(), recorder)
+
+	// Upload data
+	blob, err := c.Client.CreateBlobFromData(ctx, "",
+		version1.NewBlobInfoV1("", "test", "progress1.dat", 0, "application/octet-stream"), content)
+	if !assert.Nil(t, err) {
+		return
+	}
+	assertTransferProgress(t, version1.BlobsTransferUpload, int64(len(content)), recorder.reset())
+
+	// Download data
+	_, _, err = c.Client.GetBlobDataById(ctx, "", blob.Id)
+	assert.Nil(t, err)
+	assertTransferProgress(t, version1.BlobsTransferDownload, int64(len(content)), recorder.reset())
+
+	// Upload stream
+	blob, err = c.Client.CreateBlobFromStream(ctx, "",
+		version1.NewBlobInfoV1("", "test", "progress2.dat", 0, "application/octet-stream"), bytes.NewReader(content))
+	if !assert.Nil(t, err) {
+		return
+	}
+	assertTransferProgress(t, version1.BlobsTransferUpload, int64(len(content)), recorder.reset())
+
+	// Download stream
+	buffer := &bytes.Buffer{}
+	_, err = c.Client.ReadBlobStreamById(ctx, "", blob.Id, buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, content, buffer.Bytes())
+	assertTransferProgress(t, version1.BlobsTransferDownload, int64(len(content)), recorder.reset())
+
+	// Transfers without observer are not reported
+	_, _, err = c.Client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Len(t, recorder.reset(), 0)
+}
@@ -32,6 +32,7 @@ func (c *blobsCommandableGrpcClientV1Test) setup(t *testing.T) {
 		"connection.protocol", "http",
 		"connection.host", GRPC_HOST,
 		"connection.port", GRPC_PORT,
+		"options.checksum", "sha256",
 	)
 
 	c.client = version1.NewBlobsCommandableGrpcClientV1()
@@ -84,3 +85,75 @@ func TestCommandableGrpcGetUriForMissingBlob(t *testing.T) {
 
 	c.fixture.TestGetUriForMissingBlob(t)
 }
+
+func TestCommandableGrpcResumeStream(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestCommandableGrpcContentChecksum(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestCommandableGrpcMetadataAndTags(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestCommandableGrpcBlobReader(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestCommandableGrpcBlobWriter(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestCommandableGrpcReadBlobRange(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestCommandableGrpcSortBlobs(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestCommandableGrpcListBlobs(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestCommandableGrpcTransferProgress(t *testing.T) {
+	c := newBlobsCommandableGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
@@ -32,6 +32,7 @@ func (c *blobsCommandableHttpClientV1Test) setup(t *testing.T) {
 		"connection.protocol", "http",
 		"connection.host", HTTP_HOST,
 		"connection.port", HTTP_PORT,
+		"options.checksum", "sha256",
 	)
 
 	c.client = version1.NewBlobsCommandableHttpClientV1()
@@ -84,3 +85,75 @@ func TestCommandableHttpGetUriForMissingBlob(t *testing.T) {
 
 	c.fixture.TestGetUriForMissingBlob(t)
 }
+
+func TestCommandableHttpResumeStream(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestCommandableHttpContentChecksum(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestCommandableHttpMetadataAndTags(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestCommandableHttpBlobReader(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestCommandableHttpBlobWriter(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestCommandableHttpReadBlobRange(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestCommandableHttpSortBlobs(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestCommandableHttpListBlobs(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestCommandableHttpTransferProgress(t *testing.T) {
+	c := newBlobsCommandableHttpClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
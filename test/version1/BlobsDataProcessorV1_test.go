@@ -0,0 +1,72 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBlobDataById(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 5)
+
+	tests := []struct {
+		name      string
+		reader    *fakeBlobsChunkyReader
+		readAhead int
+		truncated int64 // bytes read before the content ended, -1 for complete content
+	}{
+		{"whole chunks", &fakeBlobsChunkyReader{content: content, size: 50}, 1, -1},
+		{"short chunks", &fakeBlobsChunkyReader{content: content, size: 50, maxChunk: 3}, 1, -1},
+		{"oversized chunks", &fakeBlobsChunkyReader{content: content, size: 50, extra: 5}, 1, -1},
+		{"empty chunk", &fakeBlobsChunkyReader{content: content, size: 50, cut: 30}, 1, 30},
+		{"content shorter than size", &fakeBlobsChunkyReader{content: content, size: 60}, 1, 50},
+		{"empty chunk ahead", &fakeBlobsChunkyReader{content: content, size: 50, cut: 30}, 3, 30},
+		{"content shorter than size ahead", &fakeBlobsChunkyReader{content: content, size: 60}, 3, 50},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := version1.NewBlobsTransferOptionsV1WithChunkSize(10)
+			options.ReadAhead = test.readAhead
+
+			result, blob, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "123",
+				"1", test.reader, options)
+			assert.True(t, test.reader.ended)
+
+			if test.truncated < 0 {
+				assert.Nil(t, err)
+				assert.Equal(t, test.reader.size, blob.Size)
+				assert.Equal(t, string(test.reader.content[:test.reader.size]), string(result))
+				return
+			}
+
+			assert.Nil(t, result)
+			assert.Nil(t, blob)
+			assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), "unexpected error %v", err)
+
+			var truncated *version1.BlobsTruncatedErrorV1
+			if assert.True(t, errors.As(err, &truncated)) {
+				assert.Equal(t, test.reader.size, truncated.Expected)
+				assert.Equal(t, test.truncated, truncated.Actual)
+			}
+		})
+	}
+}
+
+func TestGetBlobDataByIdNotFound(t *testing.T) {
+	reader := &fakeBlobsChunkyReader{missing: true}
+
+	result, blob, err := version1.BlobsDataProcessorV1.GetBlobDataById(context.Background(), "123", "1", reader, 10)
+	assert.Nil(t, result)
+	assert.Nil(t, blob)
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "BLOB_NOT_FOUND", appErr.Code)
+	}
+}
@@ -0,0 +1,264 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type blobsEncryptingClientV1Test struct {
+	keyFile string
+	store   *version1.BlobsMockClientV1
+	client  *version1.BlobsEncryptingClientV1
+	fixture *BlobsClientFixtureV1
+}
+
+func newBlobsEncryptingClientV1Test() *blobsEncryptingClientV1Test {
+	return &blobsEncryptingClientV1Test{}
+}
+
+func newBlobsKeyFile(t *testing.T) string {
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	assert.Nil(t, err)
+
+	keyFile := filepath.Join(t.TempDir(), "master.key")
+	err = os.WriteFile(keyFile, []byte(hex.EncodeToString(key)+"\n"), 0600)
+	assert.Nil(t, err)
+	return keyFile
+}
+
+func (c *blobsEncryptingClientV1Test) setup(t *testing.T) {
+	c.keyFile = newBlobsKeyFile(t)
+	c.store = version1.NewBlobsMockClientV1()
+	c.client = version1.NewBlobsEncryptingClientV1(c.store, nil)
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"encryption.key_file", c.keyFile,
+		"options.chunk_size", 7,
+	))
+	c.fixture = NewBlobsClientFixtureV1(c.client)
+}
+
+func (c *blobsEncryptingClientV1Test) teardown(t *testing.T) {
+	c.client = nil
+	c.store = nil
+}
+
+func TestEncryptingReadWriteData(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteData(t)
+}
+
+func TestEncryptingReadWriteStream(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestEncryptingMetadataAndTags(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestEncryptingBlobReader(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestEncryptingReadBlobRange(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestEncryptingSortBlobs(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestEncryptingListBlobs(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestEncryptingStoresCiphertext(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	content := []byte("Customer document with personal data")
+	request := version1.NewBlobInfoV1("", "test", "document.txt", 0, "text/plain")
+	request.Metadata = map[string]string{"owner": "alice"}
+	blob, err := c.client.CreateBlobFromData(context.Background(), "", request, content)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(content)), blob.Size)
+	assert.Equal(t, map[string]string{"owner": "alice"}, blob.Metadata)
+
+	// Encryption parameters do not leak into the blob of the caller
+	assert.Equal(t, map[string]string{"owner": "alice"}, request.Metadata)
+
+	// The store has only encrypted content and wrapped key
+	stored, info, err := c.store.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.False(t, bytes.Contains(stored, []byte("personal")))
+	assert.Equal(t, version1.BlobEncryptionSchemeV1, info.Metadata["encryption.scheme"])
+	assert.NotEmpty(t, info.Metadata["encryption.key"])
+	assert.Equal(t, "alice", info.Metadata["owner"])
+
+	// Updates keep encryption parameters
+	blob.Metadata["owner"] = "bob"
+	_, err = c.client.UpdateBlobInfo(context.Background(), "", blob)
+	assert.Nil(t, err)
+
+	result, blob, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+	assert.Equal(t, "bob", blob.Metadata["owner"])
+
+	// Empty content
+	blob, err = c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "empty.txt", 0, "text/plain"), []byte{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), blob.Size)
+
+	result, _, err = c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Len(t, result, 0)
+
+	// Direct links would expose encrypted content
+	_, err = c.client.GetBlobUriById(context.Background(), "", blob.Id)
+	assert.NotNil(t, err)
+}
+
+func TestEncryptingRequiresSameMasterKey(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "document.txt", 0, "text/plain"), []byte("Secret"))
+	assert.Nil(t, err)
+
+	other := version1.NewBlobsEncryptingClientV1(c.store,
+		version1.NewBlobsFileKeyProviderV1(newBlobsKeyFile(t)))
+	_, _, err = other.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.NotNil(t, err)
+}
+
+func TestEncryptingDetectsTruncation(t *testing.T) {
+	c := newBlobsEncryptingClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "document.txt", 0, "text/plain"), []byte("0123456789ABCDEFGHIJ"))
+	assert.Nil(t, err)
+
+	// Drop the last segment of 7 bytes with 16 bytes tag
+	stored, info, err := c.store.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	info.Id = ""
+	info, err = c.store.CreateBlobFromData(context.Background(), "", info, stored[:2*23])
+	assert.Nil(t, err)
+
+	_, _, err = c.client.GetBlobDataById(context.Background(), "", info.Id)
+	var appErr *cerr.ApplicationError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, version1.BlobDecryptionFailed, appErr.Code)
+}
+
+// plainBlobsClient exposes only the methods of IBlobsClientV1
+type plainBlobsClient struct {
+	version1.IBlobsClientV1
+}
+
+func TestEncryptingRangeNeedsRangeReader(t *testing.T) {
+	store := version1.NewBlobsMockClientV1()
+	client := version1.NewBlobsEncryptingClientV1(&plainBlobsClient{IBlobsClientV1: store}, nil)
+
+	blob, err := store.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, ""), []byte("0123456789"))
+	assert.Nil(t, err)
+
+	_, _, err = client.GetBlobRangeById(context.Background(), "", blob.Id, 2, 4)
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "RANGE_NOT_SUPPORTED", appErr.Code)
+	}
+
+	// Whole blobs are still read through the wrapped client
+	result, _, err := client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "0123456789", string(result))
+}
+
+func TestEncryptingSortNeedsSortedReader(t *testing.T) {
+	store := version1.NewBlobsMockClientV1()
+	client := version1.NewBlobsEncryptingClientV1(&plainBlobsClient{IBlobsClientV1: store}, nil)
+
+	_, err := store.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, ""), []byte("0123456789"))
+	assert.Nil(t, err)
+
+	_, err = client.GetBlobsByFilterWithSort(context.Background(), "", nil, nil,
+		data.NewSortParams([]data.SortField{data.NewSortField("name", true)}))
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "SORT_NOT_SUPPORTED", appErr.Code)
+	}
+
+	// Unsorted pages are still listed through the wrapped client
+	page, err := client.GetBlobsByFilter(context.Background(), "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 1)
+}
+
+func TestEncryptingRejectsContentEncoding(t *testing.T) {
+	client := version1.NewBlobsEncryptingClientV1(version1.NewBlobsMockClientV1(), nil)
+	client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"encryption.key_file", newBlobsKeyFile(t),
+		"options.content_encoding", "gzip",
+	))
+
+	err := client.Open(context.Background(), "")
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "UNSUPPORTED_ENCODING", appErr.Code)
+	}
+
+	_, err = client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.txt", 0, "text/plain"), []byte("0123456789"))
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "UNSUPPORTED_ENCODING", appErr.Code)
+	}
+}
@@ -0,0 +1,263 @@
+package test_version1
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type blobsFileClientV1Test struct {
+	path    string
+	client  *version1.BlobsFileClientV1
+	fixture *BlobsClientFixtureV1
+}
+
+func newBlobsFileClientV1Test() *blobsFileClientV1Test {
+	return &blobsFileClientV1Test{}
+}
+
+func (c *blobsFileClientV1Test) setup(t *testing.T) {
+	c.path = t.TempDir()
+	c.client = version1.NewBlobsFileClientV1()
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"path", c.path,
+		"options.chunk_size", 7,
+		"options.checksum", "sha256",
+	))
+	c.fixture = NewBlobsClientFixtureV1(c.client)
+}
+
+func (c *blobsFileClientV1Test) teardown(t *testing.T) {
+	c.client = nil
+}
+
+func TestFileReadWriteChunks(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteChunks(t)
+}
+
+func TestFileReadWriteData(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteData(t)
+}
+
+func TestFileReadWriteStream(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestFileEmptyBlob(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestEmptyBlob(t)
+}
+
+func TestFileGetUriForMissingBlob(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestGetUriForMissingBlob(t)
+}
+
+func TestFileParallelReadWriteData(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.upload_concurrency", 3,
+		"options.read_ahead", 3,
+	))
+
+	c.fixture.TestReadWriteData(t)
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestFileResumeStream(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestFileContentChecksum(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestFileMetadataAndTags(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestFileBlobReader(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestFileBlobWriter(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestFileReadBlobRange(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestFileSurvivesRestart(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	blob := version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary")
+	blob.Tags = []string{"persistent"}
+	content := []byte("Content that survives restart")
+
+	blob, err := c.client.CreateBlobFromData(context.Background(), "", blob, content)
+	assert.Nil(t, err)
+
+	uri, err := c.client.GetBlobUriById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(uri, "file://"))
+
+	// Open the same folder with a new client
+	client := version1.NewBlobsFileClientV1WithPath(c.path)
+
+	result, blob1, err := client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+	assert.Equal(t, blob.Checksum, blob1.Checksum)
+	assert.Equal(t, []string{"persistent"}, blob1.Tags)
+
+	// Ids cannot escape the folder
+	blob1, err = client.GetBlobById(context.Background(), "", "../"+blob.Id)
+	assert.Nil(t, err)
+	assert.Nil(t, blob1)
+}
+
+func TestFileSortBlobs(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestFileListBlobs(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestFileCompressedContent(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "gzip",
+	))
+
+	c.fixture.TestCompressedContent(t)
+}
+
+func TestFileTransferProgress(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
+
+func TestFileWriteBlobParts(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestWriteBlobParts(t)
+}
+
+func TestFileRewriteKeepsBlobUntilEnd(t *testing.T) {
+	c := newBlobsFileClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), []byte("Old content"))
+	assert.Nil(t, err)
+
+	// Aborted rewrite leaves the blob intact
+	blob1 := version1.NewBlobInfoV1(blob.Id, "test", "new.dat", 0, "application/binary")
+	token, err := c.client.BeginBlobWrite(context.Background(), "", blob1)
+	assert.Nil(t, err)
+	assert.NotEqual(t, blob.Id, token)
+
+	_, err = c.client.WriteBlobChunk(context.Background(), "", token, []byte("New"))
+	assert.Nil(t, err)
+
+	result, blob2, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "Old content", string(result))
+	assert.Equal(t, "file.dat", blob2.Name)
+
+	err = c.client.AbortBlobWrite(context.Background(), "", token)
+	assert.Nil(t, err)
+
+	result, _, err = c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "Old content", string(result))
+
+	// Completed rewrite replaces content and information
+	token, err = c.client.BeginBlobWrite(context.Background(), "", blob1)
+	assert.Nil(t, err)
+	_, err = c.client.WriteBlobChunk(context.Background(), "", token, []byte("New "))
+	assert.Nil(t, err)
+	_, err = c.client.EndBlobWrite(context.Background(), "", token, []byte("content"))
+	assert.Nil(t, err)
+
+	result, blob2, err = c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, "New content", string(result))
+	assert.Equal(t, "new.dat", blob2.Name)
+
+	// Finished writes leave no files behind
+	uploads, _ := filepath.Glob(filepath.Join(c.path, "uploads", "*"))
+	assert.Len(t, uploads, 0)
+}
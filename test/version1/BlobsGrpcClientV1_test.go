@@ -32,6 +32,7 @@ func (c *blobsGrpcClientV1Test) setup(t *testing.T) {
 		"connection.protocol", "http",
 		"connection.host", GRPC_HOST,
 		"connection.port", GRPC_PORT,
+		"options.checksum", "sha256",
 	)
 
 	c.client = version1.NewBlobGrpcClientV1()
@@ -84,3 +85,75 @@ func TestGrpcGetUriForMissingBlob(t *testing.T) {
 
 	c.fixture.TestGetUriForMissingBlob(t)
 }
+
+func TestGrpcResumeStream(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestGrpcContentChecksum(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestGrpcMetadataAndTags(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestGrpcBlobReader(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestGrpcBlobWriter(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestGrpcReadBlobRange(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestGrpcSortBlobs(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestGrpcListBlobs(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestGrpcTransferProgress(t *testing.T) {
+	c := newBlobsGrpcClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
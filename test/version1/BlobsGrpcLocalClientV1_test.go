@@ -0,0 +1,371 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type blobsGrpcLocalClientV1Test struct {
+	service *BlobsGrpcMockServiceV1
+	client  *version1.BlobGrpcClientV1
+	fixture *BlobsClientFixtureV1
+}
+
+func newBlobsGrpcLocalClientV1Test(streaming bool) *blobsGrpcLocalClientV1Test {
+	return &blobsGrpcLocalClientV1Test{
+		service: NewBlobsGrpcMockServiceV1(streaming),
+	}
+}
+
+func (c *blobsGrpcLocalClientV1Test) setup(t *testing.T) {
+	err := c.service.Open()
+	assert.Nil(t, err)
+
+	var grpcConfig = config.NewConfigParamsFromTuples(
+		"connection.protocol", "http",
+		"connection.host", "127.0.0.1",
+		"connection.port", c.service.Port,
+		"options.chunk_size", 5,
+		"options.checksum", "sha256",
+	)
+
+	c.client = version1.NewBlobGrpcClientV1()
+	c.client.Configure(context.Background(), grpcConfig)
+	err = c.client.Open(context.Background(), "")
+	assert.Nil(t, err)
+
+	c.fixture = NewBlobsClientFixtureV1(c.client)
+}
+
+func (c *blobsGrpcLocalClientV1Test) teardown(t *testing.T) {
+	c.client.Close(context.Background(), "")
+	c.service.Close()
+}
+
+func TestGrpcLocalReadWriteChunks(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteChunks(t)
+}
+
+func TestGrpcLocalStreamingReadWriteData(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteData(t)
+
+	uploads, downloads := c.service.StreamCalls()
+	assert.Equal(t, 1, uploads)
+	assert.Equal(t, 1, downloads)
+}
+
+func TestGrpcLocalStreamingReadWriteStream(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteStream(t)
+
+	uploads, downloads := c.service.StreamCalls()
+	assert.Equal(t, 1, uploads)
+	assert.Equal(t, 1, downloads)
+}
+
+func TestGrpcLocalChunkyReadWriteData(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteData(t)
+
+	uploads, downloads := c.service.StreamCalls()
+	assert.Equal(t, 0, uploads)
+	assert.Equal(t, 0, downloads)
+}
+
+func TestGrpcLocalChunkyReadWriteStream(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadWriteStream(t)
+
+	uploads, downloads := c.service.StreamCalls()
+	assert.Equal(t, 0, uploads)
+	assert.Equal(t, 0, downloads)
+}
+
+func TestGrpcLocalGetUriForMissingBlob(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestGetUriForMissingBlob(t)
+}
+
+func TestGrpcLocalResumeStream(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestGrpcLocalStreamingContentChecksum(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestGrpcLocalChunkyContentChecksum(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestGrpcLocalMetadataAndTags(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestGrpcLocalBlobReader(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestGrpcLocalBlobWriter(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestGrpcLocalReadBlobRange(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestGrpcLocalSortBlobs(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestGrpcLocalListBlobs(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+func TestGrpcLocalChunkyCompressedContent(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "gzip",
+	))
+
+	c.fixture.TestCompressedContent(t)
+}
+
+func TestGrpcLocalStreamingCompressedContent(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "gzip",
+	))
+
+	c.fixture.TestCompressedContent(t)
+}
+
+func TestGrpcLocalChunkyTransferProgress(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(false)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
+
+func TestGrpcLocalStreamingTransferProgress(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
+
+// lateFailingReader fails at the end of content once the server started receiving the upload
+type lateFailingReader struct {
+	reader  io.Reader
+	started func() bool
+}
+
+func (c *lateFailingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	if err == io.EOF {
+		for !c.started() {
+			time.Sleep(time.Millisecond)
+		}
+		err = errors.New("connection lost")
+	}
+	return n, err
+}
+
+func TestGrpcLocalStreamingAbortsFailedUpload(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.setup(t)
+	defer c.teardown(t)
+
+	stream := &lateFailingReader{
+		reader: bytes.NewReader([]byte("0123456789ABCDEF")),
+		started: func() bool {
+			uploads, _ := c.service.StreamCalls()
+			return uploads > 0
+		},
+	}
+	blob, err := c.client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("1", "test", "file.dat", 0, "application/octet-stream"), stream)
+	assert.Nil(t, blob)
+	assert.NotNil(t, err)
+
+	// The server sees the cancelled stream and does not commit the partial content
+	assert.Eventually(t, func() bool { return c.service.StreamAborts() == 1 }, time.Second, 10*time.Millisecond)
+	page, err := c.service.client.GetBlobsByFilter(context.Background(), "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 0)
+}
+
+func TestGrpcLocalStreamingRetriesUpload(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.service.FailUploads = 1
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.retries.attempts", 2,
+		"options.retries.min_timeout", 1,
+	))
+
+	blob, err := c.client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, ""), bytes.NewReader([]byte("0123456789ABCDEF")))
+	assert.Nil(t, err)
+	if assert.NotNil(t, blob) {
+		assert.NotEmpty(t, blob.Id)
+		assert.Equal(t, int64(16), blob.Size)
+	}
+
+	uploads, _ := c.service.StreamCalls()
+	assert.Equal(t, 2, uploads)
+
+	data, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("0123456789ABCDEF"), data)
+}
+
+func TestGrpcLocalStreamingTruncatedDownload(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.service.TruncateDownloads = true
+	c.setup(t)
+	defer c.teardown(t)
+
+	_, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), []byte("0123456789ABCDEF"))
+	assert.Nil(t, err)
+
+	data, blob, err := c.client.GetBlobDataById(context.Background(), "", "1")
+	assert.Nil(t, data)
+	assert.Nil(t, blob)
+
+	var truncated *version1.BlobsTruncatedErrorV1
+	if assert.True(t, errors.As(err, &truncated)) {
+		assert.Equal(t, int64(16), truncated.Expected)
+		assert.Equal(t, int64(5), truncated.Actual)
+	}
+}
+
+func TestGrpcLocalStreamingDownloadWithoutBlobInfo(t *testing.T) {
+	c := newBlobsGrpcLocalClientV1Test(true)
+	c.service.SkipBlobInfo = true
+	c.setup(t)
+	defer c.teardown(t)
+
+	data, blob, err := c.client.GetBlobDataById(context.Background(), "", "1")
+	assert.Nil(t, data)
+	assert.Nil(t, blob)
+
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "BLOB_NOT_FOUND", appErr.Code)
+	}
+}
+
+func TestGrpcLocalParallelUploadNeedsPartSupport(t *testing.T) {
+	for _, supported := range []bool{false, true} {
+		service := NewBlobsGrpcMockServiceV1(supported)
+		err := service.Open()
+		assert.Nil(t, err)
+		defer service.Close()
+
+		// Chunks go through the chunky protocol, so only the parts depend on the server
+		client := version1.NewBlobGrpcClientV1()
+		client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+			"connection.protocol", "http",
+			"connection.host", "127.0.0.1",
+			"connection.port", service.Port,
+			"options.streaming", false,
+			"options.chunk_size", 5,
+			"options.upload_concurrency", 3,
+		))
+		err = client.Open(context.Background(), "")
+		assert.Nil(t, err)
+		defer client.Close(context.Background(), "")
+
+		content := []byte("0123456789ABCDEFGHIJ")
+		blob, err := client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", "file.dat", 0, ""), content)
+		assert.Nil(t, err)
+
+		result, _, err := client.GetBlobDataById(context.Background(), "", blob.Id)
+		assert.Nil(t, err)
+		assert.Equal(t, content, result)
+
+		if supported {
+			assert.Greater(t, service.PartCalls(), 0)
+		} else {
+			assert.Equal(t, 0, service.PartCalls())
+		}
+	}
+}
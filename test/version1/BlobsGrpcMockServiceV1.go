@@ -0,0 +1,362 @@
+package test_version1
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/protos"
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/convert"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"google.golang.org/grpc"
+)
+
+// BlobsGrpcMockServiceV1 is an in-process gRPC blobs service backed by BlobsMockClientV1.
+// When streaming is disabled it behaves like a server that only knows the chunky protocol.
+type BlobsGrpcMockServiceV1 struct {
+	protos.UnimplementedBlobsServer
+	client    *version1.BlobsMockClientV1
+	streaming bool
+	server    *grpc.Server
+	uploads   int32
+	downloads int32
+	aborts    int32
+	parts     int32
+	Port      string
+
+	// Faults of download_blob: content is cut after the first chunk
+	// or no blob info is sent at all
+	TruncateDownloads bool
+	SkipBlobInfo      bool
+
+	// Fault of upload_blob: the given number of uploads fail after the content is received
+	FailUploads int32
+}
+
+func NewBlobsGrpcMockServiceV1(streaming bool) *BlobsGrpcMockServiceV1 {
+	return &BlobsGrpcMockServiceV1{
+		client:    version1.NewBlobsMockClientV1(),
+		streaming: streaming,
+	}
+}
+
+func (c *BlobsGrpcMockServiceV1) Open() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	c.Port = strconv.Itoa(listener.Addr().(*net.TCPAddr).Port)
+	c.server = grpc.NewServer()
+	protos.RegisterBlobsServer(c.server, c)
+
+	go c.server.Serve(listener)
+
+	return nil
+}
+
+func (c *BlobsGrpcMockServiceV1) Close() {
+	if c.server != nil {
+		c.server.Stop()
+		c.server = nil
+	}
+}
+
+// StreamAborts returns the number of upload_blob calls aborted by the client
+func (c *BlobsGrpcMockServiceV1) StreamAborts() int {
+	return int(atomic.LoadInt32(&c.aborts))
+}
+
+// PartCalls returns the number of served write_blob_part calls
+func (c *BlobsGrpcMockServiceV1) PartCalls() int {
+	return int(atomic.LoadInt32(&c.parts))
+}
+
+// StreamCalls returns the number of served upload_blob and download_blob calls
+func (c *BlobsGrpcMockServiceV1) StreamCalls() (uploads int, downloads int) {
+	return int(atomic.LoadInt32(&c.uploads)), int(atomic.LoadInt32(&c.downloads))
+}
+
+func fromError(err error) *protos.ErrorDescription {
+	if err == nil {
+		return nil
+	}
+
+	desc := errors.ErrorDescriptionFactory.Create(err)
+	return &protos.ErrorDescription{
+		Type:          desc.Type,
+		Category:      desc.Category,
+		Code:          desc.Code,
+		CorrelationId: desc.CorrelationId,
+		Status:        convert.StringConverter.ToString(desc.Status),
+		Message:       desc.Message,
+	}
+}
+
+func fromBlobInfo(blob *version1.BlobInfoV1) *protos.BlobInfo {
+	if blob == nil {
+		return nil
+	}
+
+	return &protos.BlobInfo{
+		Id:          blob.Id,
+		Group:       blob.Group,
+		Name:        blob.Name,
+		Size:        blob.Size,
+		ContentType: blob.ContentType,
+		CreateTime:  convert.StringConverter.ToString(blob.CreateTime),
+		ExpireTime:  convert.StringConverter.ToString(blob.ExpireTime),
+		Completed:   blob.Completed,
+
+		ChecksumAlgorithm: blob.ChecksumAlgorithm,
+		Checksum:          blob.Checksum,
+
+		Metadata: blob.Metadata,
+		Tags:     blob.Tags,
+
+		ContentEncoding: blob.ContentEncoding,
+	}
+}
+
+func toBlobInfo(obj *protos.BlobInfo) *version1.BlobInfoV1 {
+	if obj == nil {
+		return nil
+	}
+
+	return &version1.BlobInfoV1{
+		Id:          obj.Id,
+		Group:       obj.Group,
+		Name:        obj.Name,
+		Size:        obj.Size,
+		ContentType: obj.ContentType,
+		CreateTime:  convert.DateTimeConverter.ToDateTime(obj.CreateTime),
+		ExpireTime:  convert.DateTimeConverter.ToDateTime(obj.ExpireTime),
+		Completed:   obj.Completed,
+
+		ChecksumAlgorithm: obj.ChecksumAlgorithm,
+		Checksum:          obj.Checksum,
+
+		Metadata: obj.Metadata,
+		Tags:     obj.Tags,
+
+		ContentEncoding: obj.ContentEncoding,
+	}
+}
+
+func fromBlobInfos(blobs []*version1.BlobInfoV1) []*protos.BlobInfo {
+	result := make([]*protos.BlobInfo, len(blobs))
+	for i, v := range blobs {
+		result[i] = fromBlobInfo(v)
+	}
+	return result
+}
+
+func (c *BlobsGrpcMockServiceV1) GetBlobsByFilter(ctx context.Context, req *protos.BlobInfoPageRequest) (*protos.BlobInfoPageReply, error) {
+	filter := data.NewFilterParamsFromValue(req.Filter)
+	var paging *data.PagingParams
+	if req.Paging != nil {
+		paging = data.NewPagingParams(req.Paging.Skip, int64(req.Paging.Take), req.Paging.Total)
+	}
+	sort := data.NewEmptySortParams()
+	for _, field := range req.Sort {
+		*sort = append(*sort, data.NewSortField(field.Name, field.Ascending))
+	}
+
+	page, err := c.client.GetBlobsByFilterWithSort(ctx, req.CorrelationId, filter, paging, sort)
+	return &protos.BlobInfoPageReply{
+		Error: fromError(err),
+		Page: &protos.BlobInfoPage{
+			Total: int64(page.Total),
+			Data:  fromBlobInfos(page.Data),
+		},
+	}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) GetBlobsByIds(ctx context.Context, req *protos.BlobIdsRequest) (*protos.BlobInfoObjectsReply, error) {
+	blobs, err := c.client.GetBlobsByIds(ctx, req.CorrelationId, req.BlobIds)
+	return &protos.BlobInfoObjectsReply{Error: fromError(err), Blobs: fromBlobInfos(blobs)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) GetBlobById(ctx context.Context, req *protos.BlobIdRequest) (*protos.BlobInfoObjectReply, error) {
+	blob, err := c.client.GetBlobById(ctx, req.CorrelationId, req.BlobId)
+	return &protos.BlobInfoObjectReply{Error: fromError(err), Blob: fromBlobInfo(blob)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) GetBlobUriById(ctx context.Context, req *protos.BlobIdRequest) (*protos.BlobUriReply, error) {
+	uri, err := c.client.GetBlobUriById(ctx, req.CorrelationId, req.BlobId)
+	return &protos.BlobUriReply{Error: fromError(err), Uri: uri}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) BeginBlobWrite(ctx context.Context, req *protos.BlobInfoObjectRequest) (*protos.BlobTokenReply, error) {
+	token, err := c.client.BeginBlobWrite(ctx, req.CorrelationId, toBlobInfo(req.Blob))
+	return &protos.BlobTokenReply{Error: fromError(err), Token: token}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) WriteBlobChunk(ctx context.Context, req *protos.BlobTokenWithChunkRequest) (*protos.BlobTokenReply, error) {
+	chunk, err := base64.StdEncoding.DecodeString(req.Chunk)
+	token := ""
+	if err == nil {
+		token, err = c.client.WriteBlobChunk(ctx, req.CorrelationId, req.Token, chunk)
+	}
+	return &protos.BlobTokenReply{Error: fromError(err), Token: token}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) WriteBlobPart(ctx context.Context, req *protos.BlobPartRequest) (*protos.BlobEmptyReply, error) {
+	atomic.AddInt32(&c.parts, 1)
+	err := c.client.WriteBlobPart(ctx, req.CorrelationId, req.Token, req.Offset, req.Chunk)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) EndBlobWrite(ctx context.Context, req *protos.BlobTokenWithChunkRequest) (*protos.BlobInfoObjectReply, error) {
+	chunk, err := base64.StdEncoding.DecodeString(req.Chunk)
+	var blob *version1.BlobInfoV1
+	if err == nil {
+		blob, err = c.client.EndBlobWrite(ctx, req.CorrelationId, req.Token, chunk)
+	}
+	return &protos.BlobInfoObjectReply{Error: fromError(err), Blob: fromBlobInfo(blob)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) AbortBlobWrite(ctx context.Context, req *protos.BlobTokenRequest) (*protos.BlobEmptyReply, error) {
+	err := c.client.AbortBlobWrite(ctx, req.CorrelationId, req.Token)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) GetBlobWriteOffset(ctx context.Context, req *protos.BlobTokenRequest) (*protos.BlobOffsetReply, error) {
+	offset, token, err := c.client.GetBlobWriteOffset(ctx, req.CorrelationId, req.Token)
+	return &protos.BlobOffsetReply{Error: fromError(err), Offset: offset, Token: token}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) BeginBlobRead(ctx context.Context, req *protos.BlobIdRequest) (*protos.BlobInfoObjectReply, error) {
+	blob, err := c.client.BeginBlobRead(ctx, req.CorrelationId, req.BlobId)
+	return &protos.BlobInfoObjectReply{Error: fromError(err), Blob: fromBlobInfo(blob)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) ReadBlobChunk(ctx context.Context, req *protos.BlobReadRequest) (*protos.BlobChunkReply, error) {
+	chunk, err := c.client.ReadBlobChunk(ctx, req.CorrelationId, req.BlobId, req.Skip, req.Take)
+	return &protos.BlobChunkReply{Error: fromError(err), Chunk: base64.StdEncoding.EncodeToString(chunk)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) EndBlobRead(ctx context.Context, req *protos.BlobIdRequest) (*protos.BlobEmptyReply, error) {
+	err := c.client.EndBlobRead(ctx, req.CorrelationId, req.BlobId)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) UploadBlob(stream protos.Blobs_UploadBlobServer) error {
+	if !c.streaming {
+		return c.UnimplementedBlobsServer.UploadBlob(stream)
+	}
+	atomic.AddInt32(&c.uploads, 1)
+
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	correlationId := req.CorrelationId
+	token, err := c.client.BeginBlobWrite(ctx, correlationId, toBlobInfo(req.Blob))
+	if err != nil {
+		return stream.SendAndClose(&protos.BlobInfoObjectReply{Error: fromError(err)})
+	}
+
+	for {
+		req, err = stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			atomic.AddInt32(&c.aborts, 1)
+			c.client.AbortBlobWrite(context.Background(), correlationId, token)
+			return err
+		}
+
+		token, err = c.client.WriteBlobChunk(ctx, correlationId, token, req.Chunk)
+		if err != nil {
+			c.client.AbortBlobWrite(ctx, correlationId, token)
+			return stream.SendAndClose(&protos.BlobInfoObjectReply{Error: fromError(err)})
+		}
+	}
+
+	if atomic.AddInt32(&c.FailUploads, -1) >= 0 {
+		c.client.AbortBlobWrite(ctx, correlationId, token)
+		err = errors.NewInvocationError(correlationId, "UPLOAD_FAILED", "Upload failed")
+		return stream.SendAndClose(&protos.BlobInfoObjectReply{Error: fromError(err)})
+	}
+
+	blob, err := c.client.EndBlobWrite(ctx, correlationId, token, nil)
+	return stream.SendAndClose(&protos.BlobInfoObjectReply{Error: fromError(err), Blob: fromBlobInfo(blob)})
+}
+
+func (c *BlobsGrpcMockServiceV1) DownloadBlob(req *protos.BlobIdRequest, stream protos.Blobs_DownloadBlobServer) error {
+	if !c.streaming {
+		return c.UnimplementedBlobsServer.DownloadBlob(req, stream)
+	}
+	atomic.AddInt32(&c.downloads, 1)
+
+	ctx := stream.Context()
+	if c.SkipBlobInfo {
+		return nil
+	}
+	blob, err := c.client.BeginBlobRead(ctx, req.CorrelationId, req.BlobId)
+	if err != nil {
+		return stream.Send(&protos.BlobDownloadReply{Error: fromError(err)})
+	}
+
+	err = stream.Send(&protos.BlobDownloadReply{Blob: fromBlobInfo(blob)})
+	if err != nil {
+		return err
+	}
+
+	size := blob.Size
+	if c.TruncateDownloads && size > 5 {
+		size = 5
+	}
+	for skip := int64(0); skip < size; skip += 5 {
+		chunk, err := c.client.ReadBlobChunk(ctx, req.CorrelationId, req.BlobId, skip, 5)
+		if err != nil {
+			return stream.Send(&protos.BlobDownloadReply{Error: fromError(err)})
+		}
+
+		err = stream.Send(&protos.BlobDownloadReply{Chunk: chunk})
+		if err != nil {
+			return err
+		}
+	}
+
+	return c.client.EndBlobRead(ctx, req.CorrelationId, req.BlobId)
+}
+
+func (c *BlobsGrpcMockServiceV1) GetFeatures(ctx context.Context, req *protos.BlobFeaturesRequest) (*protos.BlobFeaturesReply, error) {
+	if !c.streaming {
+		return c.UnimplementedBlobsServer.GetFeatures(ctx, req)
+	}
+
+	return &protos.BlobFeaturesReply{
+		Features: []string{"upload_blob", "download_blob", "write_blob_part"},
+	}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) UpdateBlobInfo(ctx context.Context, req *protos.BlobInfoObjectRequest) (*protos.BlobInfoObjectReply, error) {
+	blob, err := c.client.UpdateBlobInfo(ctx, req.CorrelationId, toBlobInfo(req.Blob))
+	return &protos.BlobInfoObjectReply{Error: fromError(err), Blob: fromBlobInfo(blob)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) MarkBlobsCompleted(ctx context.Context, req *protos.BlobIdsRequest) (*protos.BlobEmptyReply, error) {
+	err := c.client.MarkBlobsCompleted(ctx, req.CorrelationId, req.BlobIds)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) DeleteBlobById(ctx context.Context, req *protos.BlobIdRequest) (*protos.BlobEmptyReply, error) {
+	err := c.client.DeleteBlobById(ctx, req.CorrelationId, req.BlobId)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
+
+func (c *BlobsGrpcMockServiceV1) DeleteBlobsByIds(ctx context.Context, req *protos.BlobIdsRequest) (*protos.BlobEmptyReply, error) {
+	err := c.client.DeleteBlobsByIds(ctx, req.CorrelationId, req.BlobIds)
+	return &protos.BlobEmptyReply{Error: fromError(err)}, nil
+}
@@ -1,9 +1,25 @@
 package test_version1
 
 import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
 )
 
 type blobsMockClientV1Test struct {
@@ -17,6 +33,9 @@ func newBlobsMockClientV1Test() *blobsMockClientV1Test {
 
 func (c *blobsMockClientV1Test) setup(t *testing.T) {
 	c.client = version1.NewBlobsMockClientV1()
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.checksum", "sha256",
+	))
 	c.fixture = NewBlobsClientFixtureV1(c.client)
 }
 
@@ -48,6 +67,14 @@ func TestMockReadWriteStream(t *testing.T) {
 	c.fixture.TestReadWriteStream(t)
 }
 
+func TestMockEmptyBlob(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestEmptyBlob(t)
+}
+
 func TestMockWritingBlobUri(t *testing.T) {
 	c := newBlobsMockClientV1Test()
 	c.setup(t)
@@ -63,3 +90,826 @@ func TestMockGetUriForMissingBlob(t *testing.T) {
 
 	c.fixture.TestGetUriForMissingBlob(t)
 }
+
+func TestMockParallelReadWriteData(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.upload_concurrency", 3,
+	))
+
+	c.fixture.TestReadWriteData(t)
+}
+
+func TestMockParallelReadWriteStream(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.upload_concurrency", 3,
+	))
+
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestMockReadAheadReadWriteData(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.read_ahead", 3,
+	))
+
+	c.fixture.TestReadWriteData(t)
+}
+
+func TestMockReadAheadReadWriteStream(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.read_ahead", 3,
+	))
+
+	c.fixture.TestReadWriteStream(t)
+}
+
+func TestMockResumeStream(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestMockResumeStreamWithFileCheckpoints(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.checkpoint_path", t.TempDir(),
+	))
+
+	c.fixture.TestResumeStream(t)
+}
+
+func TestMockContentChecksum(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestContentChecksum(t)
+}
+
+func TestMockMd5Checksum(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.checksum", "md5",
+	))
+
+	content := []byte("Content with md5 digest")
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), content)
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ChecksumMd5, blob.ChecksumAlgorithm)
+	assert.Equal(t, version1.ComputeBlobChecksumV1(version1.ChecksumMd5, content), blob.Checksum)
+
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+}
+
+func TestMockNoChecksumByDefault(t *testing.T) {
+	client := version1.NewBlobsMockClientV1()
+
+	content := []byte("Content without digest")
+	blob, err := client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), content)
+	assert.Nil(t, err)
+	assert.Equal(t, "", blob.ChecksumAlgorithm)
+	assert.Equal(t, "", blob.Checksum)
+}
+
+func TestMockRejectsWrongChecksum(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	blob := version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary")
+	blob.ChecksumAlgorithm = version1.ChecksumSha256
+	blob.Checksum = version1.ComputeBlobChecksumV1(version1.ChecksumSha256, []byte("Other content"))
+
+	token, err := c.client.BeginBlobWrite(context.Background(), "", blob)
+	assert.Nil(t, err)
+
+	_, err = c.client.EndBlobWrite(context.Background(), "", token, []byte("Content"))
+	var integrityErr *version1.BlobsIntegrityErrorV1
+	assert.True(t, errors.As(err, &integrityErr))
+
+	blob, err = c.client.GetBlobById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Nil(t, blob)
+}
+
+func TestMockMetadataAndTags(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestMetadataAndTags(t)
+}
+
+func TestMockBlobReader(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestMockChunkedBlobReader(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 7,
+	))
+
+	c.fixture.TestBlobReader(t)
+}
+
+func TestMockBlobWriter(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestBlobWriter(t)
+}
+
+func TestMockReadBlobRange(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestMockChunkedReadBlobRange(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+	))
+
+	c.fixture.TestReadBlobRange(t)
+}
+
+func TestMockDeleteBlobsByIds(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	ids := make([]string, 0)
+	for i := 0; i < 5; i++ {
+		blob, err := c.client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", "file"+strconv.Itoa(i)+".dat", 0, "application/binary"), []byte("Content"))
+		assert.Nil(t, err)
+		ids = append(ids, blob.Id)
+	}
+
+	// Adjacent blobs are removed together
+	err := c.client.DeleteBlobsByIds(context.Background(), "", ids[1:4])
+	assert.Nil(t, err)
+
+	page, err := c.client.GetBlobsByFilter(context.Background(), "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, ids[0], page.Data[0].Id)
+	assert.Equal(t, ids[4], page.Data[1].Id)
+
+	_, _, err = c.client.GetBlobDataById(context.Background(), "", ids[2])
+	assert.NotNil(t, err)
+}
+
+func TestMockConcurrentAccess(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 3,
+	))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			content := []byte("Content of blob number " + strconv.Itoa(i))
+			blob, err := c.client.CreateBlobFromData(context.Background(), "",
+				version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), content)
+			assert.Nil(t, err)
+
+			result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+			assert.Nil(t, err)
+			assert.Equal(t, content, result)
+
+			_, err = c.client.GetBlobsByFilter(context.Background(), "", nil, nil)
+			assert.Nil(t, err)
+
+			err = c.client.DeleteBlobById(context.Background(), "", blob.Id)
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+
+	page, err := c.client.GetBlobsByFilter(context.Background(), "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 0)
+}
+
+func TestMockSeparateWriteTokens(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	token1, err := c.client.BeginBlobWrite(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file1.dat", 0, "application/binary"))
+	assert.Nil(t, err)
+	token2, err := c.client.BeginBlobWrite(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file2.dat", 0, "application/binary"))
+	assert.Nil(t, err)
+	assert.NotEqual(t, token1, token2)
+
+	_, err = c.client.WriteBlobChunk(context.Background(), "", token1, []byte("ABC"))
+	assert.Nil(t, err)
+	_, err = c.client.WriteBlobChunk(context.Background(), "", token2, []byte("123"))
+	assert.Nil(t, err)
+
+	blob1, err := c.client.EndBlobWrite(context.Background(), "", token1, []byte("DEF"))
+	assert.Nil(t, err)
+	err = c.client.AbortBlobWrite(context.Background(), "", token2)
+	assert.Nil(t, err)
+
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob1.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ABCDEF"), result)
+
+	// Finished tokens can not be reused
+	_, err = c.client.WriteBlobChunk(context.Background(), "", token1, []byte("GHI"))
+	assert.NotNil(t, err)
+}
+
+func TestMockPagingAndSorting(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	for _, name := range []string{"c.dat", "a.dat", "e.dat", "b.dat", "d.dat"} {
+		_, err := c.client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", name, 0, "application/binary"), []byte(name))
+		assert.Nil(t, err)
+	}
+	_, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "other", "f.dat", 0, "application/binary"), []byte("f.dat"))
+	assert.Nil(t, err)
+
+	// Total is the number of filtered blobs
+	page, err := c.client.GetBlobsByFilterWithSort(context.Background(), "",
+		data.NewFilterParamsFromTuples("group", "test"), data.NewPagingParams(1, 2, true),
+		data.NewSortParams([]data.SortField{data.NewSortField("name", true)}))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, page.Total)
+	assert.Len(t, page.Data, 2)
+	assert.Equal(t, "b.dat", page.Data[0].Name)
+	assert.Equal(t, "c.dat", page.Data[1].Name)
+
+	page, err = c.client.GetBlobsByFilterWithSort(context.Background(), "",
+		data.NewFilterParamsFromTuples("group", "test"), data.NewPagingParams(4, 10, false),
+		data.NewSortParams([]data.SortField{data.NewSortField("name", false)}))
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 1)
+	assert.Equal(t, "a.dat", page.Data[0].Name)
+
+	// Without sort blobs are listed by create time
+	page, err = c.client.GetBlobsByFilter(context.Background(), "",
+		nil, data.NewPagingParams(0, 3, true))
+	assert.Nil(t, err)
+	assert.Equal(t, 6, page.Total)
+	assert.Len(t, page.Data, 3)
+	assert.Equal(t, "c.dat", page.Data[0].Name)
+	assert.Equal(t, "a.dat", page.Data[1].Name)
+	assert.Equal(t, "e.dat", page.Data[2].Name)
+
+	// Zero take returns the default page size
+	page, err = c.client.GetBlobsByFilter(context.Background(), "",
+		nil, data.NewPagingParams(0, 0, false))
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 6)
+}
+
+func TestMockSortBlobs(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestSortBlobs(t)
+}
+
+func TestMockListBlobs(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestListBlobs(t)
+}
+
+type failingPageBlobsClient struct {
+	*version1.BlobsMockClientV1
+}
+
+func (c *failingPageBlobsClient) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams) (data.DataPage[*version1.BlobInfoV1], error) {
+	if paging.GetSkip(0) > 0 {
+		return *data.NewEmptyDataPage[*version1.BlobInfoV1](), errors.New("page is not available")
+	}
+	return c.BlobsMockClientV1.GetBlobsByFilter(ctx, correlationId, filter, paging)
+}
+
+func TestMockListBlobsWithPageSize(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.page_size", 2,
+	))
+
+	for i := 0; i < 5; i++ {
+		_, err := c.client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", "file"+strconv.Itoa(i)+".dat", 0, "application/binary"), []byte("Content"))
+		assert.Nil(t, err)
+	}
+
+	// Stop in the middle
+	iterator := c.client.ListBlobs(context.Background(), "", nil)
+	assert.True(t, iterator.Next())
+	assert.True(t, iterator.Next())
+	assert.True(t, iterator.Next())
+	assert.Equal(t, "file2.dat", iterator.Blob().Name)
+	iterator.Close()
+	assert.False(t, iterator.Next())
+	assert.Nil(t, iterator.Err())
+
+	// Failed page stops the iteration
+	client := &failingPageBlobsClient{BlobsMockClientV1: c.client}
+	options := version1.NewBlobsTransferOptionsV1()
+	options.PageSize = 2
+	iterator = version1.NewBlobsIteratorV1(context.Background(), "", client, nil, options)
+	defer iterator.Close()
+
+	count := 0
+	for iterator.Next() {
+		count++
+	}
+	assert.Equal(t, 2, count)
+	assert.NotNil(t, iterator.Err())
+}
+
+// cappingPageBlobsClient returns at most maxTake blobs per page like services that limit page size
+type cappingPageBlobsClient struct {
+	*version1.BlobsMockClientV1
+	maxTake int64
+	noTotal bool
+	calls   int32
+}
+
+func (c *cappingPageBlobsClient) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams) (data.DataPage[*version1.BlobInfoV1], error) {
+	atomic.AddInt32(&c.calls, 1)
+	if paging.Take > c.maxTake {
+		paging = data.NewPagingParams(paging.GetSkip(0), c.maxTake, paging.Total && !c.noTotal)
+	}
+	return c.BlobsMockClientV1.GetBlobsByFilter(ctx, correlationId, filter, paging)
+}
+
+func TestMockListBlobsWithCappedPages(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	for i := 0; i < 5; i++ {
+		_, err := c.client.CreateBlobFromData(context.Background(), "",
+			version1.NewBlobInfoV1("", "test", "file"+strconv.Itoa(i)+".dat", 0, "application/binary"), []byte("Content"))
+		assert.Nil(t, err)
+	}
+
+	tests := []struct {
+		name    string
+		noTotal bool
+		calls   int
+	}{
+		{"with total", false, 3},
+		{"without total", true, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := &cappingPageBlobsClient{BlobsMockClientV1: c.client, maxTake: 2, noTotal: test.noTotal}
+			iterator := version1.NewBlobsIteratorV1(context.Background(), "", client, nil, nil)
+			defer iterator.Close()
+
+			// Pages shorter than the page size do not end the iteration
+			count := 0
+			for iterator.Next() {
+				count++
+			}
+			assert.Nil(t, iterator.Err())
+			assert.Equal(t, 5, count)
+			assert.Equal(t, test.calls, int(atomic.LoadInt32(&client.calls)))
+		})
+	}
+}
+
+func TestMockCompressedContent(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "gzip",
+	))
+
+	c.fixture.TestCompressedContent(t)
+}
+
+func TestMockRawCompressedContent(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "gzip",
+	))
+
+	content := bytes.Repeat([]byte("a,b,c,d\n"), 100)
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.csv", 0, "text/csv"), content)
+	assert.Nil(t, err)
+
+	// Stored bytes are returned as is
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.raw_content", true,
+	))
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, blob.Size, int64(len(result)))
+
+	gzipReader, err := gzip.NewReader(bytes.NewReader(result))
+	assert.Nil(t, err)
+	result, err = io.ReadAll(gzipReader)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+}
+
+func TestMockZstdContent(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "zstd",
+		"options.chunk_size", 100,
+	))
+
+	content := bytes.Repeat([]byte("a,b,c,d\n"), 500)
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file1.csv", 0, "text/csv"), content)
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ContentEncodingZstd, blob.ContentEncoding)
+	assert.True(t, blob.Size < int64(len(content)))
+
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+
+	blob, err = c.client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file2.csv", 0, "text/csv"), bytes.NewReader(content))
+	assert.Nil(t, err)
+	assert.Equal(t, version1.ContentEncodingZstd, blob.ContentEncoding)
+
+	buffer := &bytes.Buffer{}
+	_, err = c.client.ReadBlobStreamById(context.Background(), "", blob.Id, buffer)
+	assert.Nil(t, err)
+	assert.Equal(t, content, buffer.Bytes())
+
+	// Stored bytes are a standard zstd frame
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.raw_content", true,
+	))
+	result, _, err = c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+
+	decoder, err := zstd.NewReader(nil)
+	assert.Nil(t, err)
+	defer decoder.Close()
+	result, err = decoder.DecodeAll(result, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+}
+
+func TestMockContentCodecs(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	// Unknown encodings need a codec registered by the application
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "brotli",
+	))
+	_, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), []byte("Content"))
+	assert.NotNil(t, err)
+
+	// Custom codec
+	version1.RegisterBlobsContentCodecV1("reverse", &version1.BlobsContentCodecV1{
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return &reverseWriteCloser{writer: w}, nil
+		},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			data, err := io.ReadAll(r)
+			return io.NopCloser(bytes.NewReader(reverseBytes(data))), err
+		},
+	})
+	c.client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.content_encoding", "reverse",
+	))
+
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, "application/binary"), []byte("Content"))
+	assert.Nil(t, err)
+	assert.Equal(t, "reverse", blob.ContentEncoding)
+
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("Content"), result)
+}
+
+type reverseWriteCloser struct {
+	writer io.Writer
+	buffer []byte
+}
+
+func (c *reverseWriteCloser) Write(p []byte) (int, error) {
+	c.buffer = append(c.buffer, p...)
+	return len(p), nil
+}
+
+func (c *reverseWriteCloser) Close() error {
+	_, err := c.writer.Write(reverseBytes(c.buffer))
+	return err
+}
+
+func reverseBytes(data []byte) []byte {
+	result := make([]byte, len(data))
+	for i, b := range data {
+		result[len(data)-1-i] = b
+	}
+	return result
+}
+
+func TestMockDetectContentType(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	png := []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+	// Sniffed from content
+	blob, err := c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "picture", 0, ""), png)
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", blob.ContentType)
+
+	blob, err = c.client.CreateBlobFromStream(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "picture", 0, ""), bytes.NewReader(png))
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", blob.ContentType)
+
+	result, _, err := c.client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, png, result)
+
+	// Extension is preferred
+	blob, err = c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "data.json", 0, ""), []byte("{}"))
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", blob.ContentType)
+
+	// Given type is kept
+	blob, err = c.client.CreateBlobFromData(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "data.json", 0, "text/x-custom"), []byte("{}"))
+	assert.Nil(t, err)
+	assert.Equal(t, "text/x-custom", blob.ContentType)
+}
+
+func TestMockUriContentType(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.4"))
+	}))
+	defer server.Close()
+
+	blob, err := c.client.CreateBlobFromUri(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "document", 0, ""), server.URL)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/pdf", blob.ContentType)
+}
+
+type countingBlobsWriter struct {
+	*version1.BlobsMockClientV1
+	begins int
+}
+
+func (c *countingBlobsWriter) BeginBlobWrite(ctx context.Context, correlationId string,
+	blob *version1.BlobInfoV1) (string, error) {
+	c.begins++
+	return c.BlobsMockClientV1.BeginBlobWrite(ctx, correlationId, blob)
+}
+
+func TestMockAllowedContentTypes(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	options := version1.NewBlobsTransferOptionsV1()
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.allowed_content_types", "image/*, application/pdf",
+	))
+	writer := &countingBlobsWriter{BlobsMockClientV1: c.client}
+
+	blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "picture.png", 0, ""), writer, []byte("content"), options)
+	assert.Nil(t, err)
+	assert.Equal(t, "image/png", blob.ContentType)
+
+	blob, err = version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "document", 0, "application/pdf; version=1.4"), writer, []byte("content"), options)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, writer.begins)
+
+	// Rejected uploads do not reach the writer
+	_, err = version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "script", 0, ""), writer, []byte("<html><script></script></html>"), options)
+	var appErr *cerr.ApplicationError
+	assert.True(t, errors.As(err, &appErr))
+	assert.Equal(t, "CONTENT_TYPE_NOT_ALLOWED", appErr.Code)
+
+	_, err = version1.BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "notes.txt", 0, ""), writer, bytes.NewReader([]byte("text")), options)
+	assert.NotNil(t, err)
+
+	_, err = version1.BlobsStreamProcessorV1.CreateBlobFromStreamResumable(context.Background(), "", "notes",
+		version1.NewBlobInfoV1("", "test", "notes", 0, ""), writer, bytes.NewReader([]byte("text")), options)
+	assert.NotNil(t, err)
+
+	_, err = version1.BlobsStreamProcessorV1.OpenBlobWriter(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "notes.txt", 0, ""), writer, options)
+	assert.NotNil(t, err)
+
+	assert.Equal(t, 2, writer.begins)
+}
+
+func TestMockTransferProgress(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestTransferProgress(t)
+}
+
+func TestMockParallelTransferProgress(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	var lock sync.Mutex
+	events := []version1.BlobsProgressV1{}
+	options := version1.NewBlobsTransferOptionsV1WithChunkSize(100)
+	options.UploadConcurrency = 4
+	options.ReadAhead = 3
+	options.Progress = version1.BlobsProgressFuncV1(func(ctx context.Context, correlationId string,
+		progress version1.BlobsProgressV1) {
+		lock.Lock()
+		defer lock.Unlock()
+		events = append(events, progress)
+	})
+	content := bytes.Repeat([]byte("0123456789"), 95)
+
+	// Size of the stream is not known until the end
+	blob, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "parallel.dat", 0, ""), c.client, bytes.NewReader(content), options)
+	assert.Nil(t, err)
+	assert.Len(t, events, 11)
+	assert.Equal(t, int64(-1), events[0].Total)
+	assert.Equal(t, time.Duration(-1), events[0].Eta)
+	assert.Equal(t, int64(len(content)), events[10].Total)
+	assert.Equal(t, int64(len(content)), events[10].Bytes)
+	assert.True(t, events[10].Done)
+
+	// Downloaded size is known from the start
+	events = nil
+	_, _, err = version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "", blob.Id,
+		c.client, options)
+	assert.Nil(t, err)
+	assert.Len(t, events, 11)
+	assert.Equal(t, int64(len(content)), events[0].Total)
+	assert.True(t, events[0].Eta >= 0)
+	assert.Equal(t, 10, events[10].Chunk)
+	assert.True(t, events[10].Throughput > 0)
+	assert.Equal(t, time.Duration(0), events[10].Eta)
+
+	// Context observer takes precedence
+	recorder := &progressRecorder{}
+	ctx := version1.WithBlobsProgressObserverV1(context.Background(), recorder)
+	events = nil
+	_, err = version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(ctx, "",
+		version1.NewBlobInfoV1("", "test", "parallel.dat", 0, ""), c.client, content, options)
+	assert.Nil(t, err)
+	assert.Len(t, events, 0)
+	assert.Len(t, recorder.reset(), 11)
+}
+
+func TestMockWriteBlobParts(t *testing.T) {
+	c := newBlobsMockClientV1Test()
+	c.setup(t)
+	defer c.teardown(t)
+
+	c.fixture.TestWriteBlobParts(t)
+}
+
+// chunkyOnlyBlobsWriter hides optional interfaces of the writer and records aborted writes
+type chunkyOnlyBlobsWriter struct {
+	version1.IBlobsChunkyWriterV1
+	aborted []string
+}
+
+func (c *chunkyOnlyBlobsWriter) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
+	c.aborted = append(c.aborted, token)
+	return c.IBlobsChunkyWriterV1.AbortBlobWrite(ctx, correlationId, token)
+}
+
+func TestMockResumeAbortsDroppedWrite(t *testing.T) {
+	client := version1.NewBlobsMockClientV1()
+	writer := &chunkyOnlyBlobsWriter{IBlobsChunkyWriterV1: client}
+	options := version1.NewBlobsTransferOptionsV1WithChunkSize(4)
+
+	// The checkpoint cannot be verified without the committed offset
+	token, err := client.BeginBlobWrite(context.Background(), "", version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""))
+	assert.Nil(t, err)
+	err = options.Checkpoints.SaveCheckpoint(context.Background(), "", &version1.BlobsUploadCheckpointV1{
+		Key: "upload", BlobId: "1", Token: token, Offset: 4,
+	})
+	assert.Nil(t, err)
+
+	blob, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamResumable(context.Background(), "", "upload",
+		version1.NewBlobInfoV1("1", "test", "file.dat", 0, ""), writer, bytes.NewReader([]byte("0123456789")), options)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10), blob.Size)
+	assert.Equal(t, []string{token}, writer.aborted)
+}
+
+func TestMockResumeRejectsContentEncoding(t *testing.T) {
+	client := version1.NewBlobsMockClientV1()
+	options := version1.NewBlobsTransferOptionsV1()
+	options.ContentEncoding = version1.ContentEncodingGzip
+
+	_, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamResumable(context.Background(), "", "upload",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, ""), client, bytes.NewReader([]byte("0123456789")), options)
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "UNSUPPORTED_ENCODING", appErr.Code)
+	}
+}
@@ -0,0 +1,35 @@
+package test_version1
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullOpenBlobReader(t *testing.T) {
+	client := version1.NewBlobsNullClientV1()
+
+	reader, err := client.OpenBlobReader(context.Background(), "123", "1")
+	assert.Nil(t, reader)
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr)) {
+		assert.Equal(t, "BLOB_NOT_FOUND", appErr.Code)
+	}
+}
+
+func TestNullOpenBlobWriter(t *testing.T) {
+	client := version1.NewBlobsNullClientV1()
+
+	writer, err := client.OpenBlobWriter(context.Background(), "123",
+		version1.NewBlobInfoV1("", "test", "file.dat", 0, ""))
+	assert.Nil(t, err)
+	if assert.NotNil(t, writer) {
+		_, err = writer.Write([]byte("0123456789"))
+		assert.Nil(t, err)
+		assert.Nil(t, writer.Close())
+	}
+}
@@ -0,0 +1,94 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterWait(t *testing.T) {
+	// Unlimited
+	var limiter *version1.BlobsRateLimiterV1
+	assert.Nil(t, limiter.Wait(context.Background(), 1000000))
+	assert.Nil(t, version1.NewBlobsRateLimiterV1(0).Wait(context.Background(), 1000000))
+
+	// Burst of one second is allowed
+	limiter = version1.NewBlobsRateLimiterV1(1000)
+	start := time.Now()
+	assert.Nil(t, limiter.Wait(context.Background(), 1000))
+	assert.Less(t, time.Since(start), 100*time.Millisecond)
+
+	// Waiting is cancelled with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start = time.Now()
+	err := limiter.Wait(ctx, 1000)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// Returned tokens are not lost
+	limiter.SetRate(10000)
+	start = time.Now()
+	assert.Nil(t, limiter.Wait(context.Background(), 500))
+	assert.Less(t, time.Since(start), 200*time.Millisecond)
+}
+
+func TestRateLimiterPacesTransfers(t *testing.T) {
+	client := version1.NewBlobsMockClientV1()
+	client.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.max_blob_size", 1000000,
+	))
+	options := version1.NewBlobsTransferOptionsV1WithChunkSize(1000)
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.max_upload_rate", 20000,
+		"options.max_download_rate", 20000,
+	))
+	content := bytes.Repeat([]byte("0123456789"), 4000)
+
+	// First second is taken by the burst
+	start := time.Now()
+	blob, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "paced.dat", 0, ""), client, bytes.NewReader(content), options)
+	assert.Nil(t, err)
+	elapsed := time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 800*time.Millisecond)
+	assert.Less(t, elapsed, 3*time.Second)
+
+	start = time.Now()
+	result, _, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "", blob.Id,
+		client, options)
+	assert.Nil(t, err)
+	assert.Equal(t, content, result)
+	elapsed = time.Since(start)
+	assert.GreaterOrEqual(t, elapsed, 800*time.Millisecond)
+	assert.Less(t, elapsed, 3*time.Second)
+}
+
+func TestRateLimiterSharing(t *testing.T) {
+	options1 := version1.NewBlobsTransferOptionsV1()
+	options1.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.max_upload_rate", 1000,
+		"options.rate_group", "backfill",
+	))
+	options2 := version1.NewBlobsTransferOptionsV1()
+	options2.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.max_upload_rate", 2000,
+		"options.max_download_rate", 3000,
+		"options.rate_group", "backfill",
+	))
+	options3 := version1.NewBlobsTransferOptionsV1()
+	options3.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.max_upload_rate", 2000,
+	))
+
+	assert.Same(t, options1.UploadLimiter, options2.UploadLimiter)
+	assert.Equal(t, int64(2000), options1.UploadLimiter.Rate())
+	assert.Nil(t, options1.DownloadLimiter)
+	assert.Equal(t, int64(3000), options2.DownloadLimiter.Rate())
+	assert.NotSame(t, options2.UploadLimiter, options3.UploadLimiter)
+}
@@ -0,0 +1,317 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// flakyBlobsClient fails the first attempt of every chunk operation.
+// When lostReplies is set, failed writes are still applied to the blob,
+// when partialReplies is set, only the first half of them.
+// When answered is set, failures are reported by the server instead of a lost connection.
+type flakyBlobsClient struct {
+	*version1.BlobsMockClientV1
+	lostReplies    bool
+	partialReplies bool
+	answered       bool
+	lock           sync.Mutex
+	attempted      map[string]bool
+	failures       int
+	writes         []string
+	queries        int
+}
+
+func newFlakyBlobsClient(lostReplies bool) *flakyBlobsClient {
+	return &flakyBlobsClient{
+		BlobsMockClientV1: version1.NewBlobsMockClientV1(),
+		lostReplies:       lostReplies,
+		attempted:         map[string]bool{},
+	}
+}
+
+func (c *flakyBlobsClient) fail(operation string) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.attempted[operation] {
+		return false
+	}
+	c.attempted[operation] = true
+	c.failures++
+	return true
+}
+
+func (c *flakyBlobsClient) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (string, error) {
+	c.lock.Lock()
+	c.writes = append(c.writes, string(chunk))
+	c.lock.Unlock()
+
+	// The attempt is known by where the chunk ends, so a resent tail is not failed again
+	committed, _, _ := c.BlobsMockClientV1.GetBlobWriteOffset(ctx, correlationId, token)
+	if c.fail("write:" + strconv.FormatInt(committed+int64(len(chunk)), 10)) {
+		if c.lostReplies {
+			c.BlobsMockClientV1.WriteBlobChunk(ctx, correlationId, token, chunk)
+		} else if c.partialReplies {
+			c.BlobsMockClientV1.WriteBlobChunk(ctx, correlationId, token, chunk[:len(chunk)/2])
+		}
+		if c.answered {
+			return "", cerr.NewInvocationError(correlationId, "WRITE_FAILED", "Write failed")
+		}
+		return "", cerr.NewConnectionError(correlationId, "CONNECTION_LOST", "Connection lost")
+	}
+	return c.BlobsMockClientV1.WriteBlobChunk(ctx, correlationId, token, chunk)
+}
+
+func (c *flakyBlobsClient) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (int64, string, error) {
+	c.lock.Lock()
+	c.queries++
+	c.lock.Unlock()
+
+	return c.BlobsMockClientV1.GetBlobWriteOffset(ctx, correlationId, token)
+}
+
+func (c *flakyBlobsClient) WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) error {
+	if c.fail("part:" + strconv.FormatInt(offset, 10)) {
+		return cerr.NewConnectionError(correlationId, "CONNECTION_LOST", "Connection lost")
+	}
+	return c.BlobsMockClientV1.WriteBlobPart(ctx, correlationId, token, offset, chunk)
+}
+
+func (c *flakyBlobsClient) ReadBlobChunk(ctx context.Context, correlationId string, blobId string, skip int64, take int64) ([]byte, error) {
+	if c.fail("read:" + strconv.FormatInt(skip, 10)) {
+		return nil, cerr.NewConnectionError(correlationId, "CONNECTION_LOST", "Connection lost")
+	}
+	return c.BlobsMockClientV1.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+}
+
+func newRetryOptions(attempts int, concurrency int) *version1.BlobsTransferOptionsV1 {
+	options := version1.NewBlobsTransferOptionsV1()
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.chunk_size", 4,
+		"options.upload_concurrency", concurrency,
+		"options.read_ahead", concurrency,
+		"options.retries.attempts", attempts,
+		"options.retries.min_timeout", 1,
+		"options.retries.max_timeout", 5,
+	))
+	return options
+}
+
+func TestRetryReadWriteData(t *testing.T) {
+	for _, concurrency := range []int{1, 3} {
+		for _, lostReplies := range []bool{false, true} {
+			client := newFlakyBlobsClient(lostReplies)
+			options := newRetryOptions(3, concurrency)
+			data := []byte("0123456789ABCDEFGHIJ")
+
+			blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+				version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, data, options)
+			assert.Nil(t, err)
+			if !assert.NotNil(t, blob) {
+				return
+			}
+			assert.Equal(t, int64(len(data)), blob.Size)
+
+			result, _, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "",
+				blob.Id, client, options)
+			assert.Nil(t, err)
+			assert.Equal(t, data, result)
+			assert.True(t, client.failures > 0)
+		}
+	}
+}
+
+func TestRetryReadWriteStream(t *testing.T) {
+	client := newFlakyBlobsClient(true)
+	options := newRetryOptions(3, 1)
+	data := []byte("0123456789ABCDEFGHIJ")
+
+	blob, err := version1.BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, bytes.NewReader(data), options)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len(data)), blob.Size)
+
+	var result bytes.Buffer
+	_, err = version1.BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(context.Background(), "",
+		blob.Id, client, &result, options)
+	assert.Nil(t, err)
+	assert.Equal(t, data, result.Bytes())
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	client := newFlakyBlobsClient(false)
+	options := newRetryOptions(1, 1)
+
+	_, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, []byte("0123456789ABCDEFGHIJ"), options)
+	assert.NotNil(t, err)
+}
+
+func TestRetrySkipsNonRetryableErrors(t *testing.T) {
+	policy := version1.NewBlobsRetryPolicyV1()
+	assert.True(t, policy.IsRetryable(cerr.NewConnectionError("", "CONNECTION_LOST", "Connection lost")))
+	assert.False(t, policy.IsRetryable(cerr.NewBadRequestError("", "BAD_REQUEST", "Bad request")))
+	assert.False(t, policy.IsRetryable(nil))
+}
+
+// rotatingBlobsClient issues a new token for every chunk and accepts only the latest one.
+// The reply to the first chunk is lost after the chunk was written.
+type rotatingBlobsClient struct {
+	*version1.BlobsMockClientV1
+	lock    sync.Mutex
+	issued  map[string]string
+	current map[string]string
+	writes  int
+}
+
+func newRotatingBlobsClient() *rotatingBlobsClient {
+	return &rotatingBlobsClient{
+		BlobsMockClientV1: version1.NewBlobsMockClientV1(),
+		issued:            map[string]string{},
+		current:           map[string]string{},
+	}
+}
+
+// resolve returns the mock token for any issued token and the latest token of that write
+func (c *rotatingBlobsClient) resolve(correlationId string, token string) (string, string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blobToken, ok := c.issued[token]
+	if !ok {
+		return "", "", cerr.NewNotFoundError(correlationId, "TOKEN_NOT_FOUND", "Token "+token+" was not found")
+	}
+	return blobToken, c.current[blobToken], nil
+}
+
+func (c *rotatingBlobsClient) resolveCurrent(correlationId string, token string) (string, error) {
+	blobToken, current, err := c.resolve(correlationId, token)
+	if err == nil && current != token {
+		err = cerr.NewBadRequestError(correlationId, "STALE_TOKEN", "Token "+token+" is no longer valid")
+	}
+	return blobToken, err
+}
+
+func (c *rotatingBlobsClient) BeginBlobWrite(ctx context.Context, correlationId string, blob *version1.BlobInfoV1) (string, error) {
+	token, err := c.BlobsMockClientV1.BeginBlobWrite(ctx, correlationId, blob)
+	if err == nil {
+		c.lock.Lock()
+		c.issued[token] = token
+		c.current[token] = token
+		c.lock.Unlock()
+	}
+	return token, err
+}
+
+func (c *rotatingBlobsClient) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (string, error) {
+	blobToken, err := c.resolveCurrent(correlationId, token)
+	if err != nil {
+		return "", err
+	}
+	if _, err = c.BlobsMockClientV1.WriteBlobChunk(ctx, correlationId, blobToken, chunk); err != nil {
+		return "", err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.writes++
+	token = blobToken + "." + strconv.Itoa(c.writes)
+	c.issued[token] = blobToken
+	c.current[blobToken] = token
+	if c.writes == 1 {
+		return "", cerr.NewConnectionError(correlationId, "CONNECTION_LOST", "Connection lost")
+	}
+	return token, nil
+}
+
+func (c *rotatingBlobsClient) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (int64, string, error) {
+	blobToken, current, err := c.resolve(correlationId, token)
+	if err != nil {
+		return 0, "", err
+	}
+
+	offset, _, err := c.BlobsMockClientV1.GetBlobWriteOffset(ctx, correlationId, blobToken)
+	return offset, current, err
+}
+
+func (c *rotatingBlobsClient) EndBlobWrite(ctx context.Context, correlationId string, token string,
+	chunk []byte) (*version1.BlobInfoV1, error) {
+	blobToken, err := c.resolveCurrent(correlationId, token)
+	if err != nil {
+		return nil, err
+	}
+	return c.BlobsMockClientV1.EndBlobWrite(ctx, correlationId, blobToken, chunk)
+}
+
+func TestRetryResendsUncommittedTail(t *testing.T) {
+	client := newFlakyBlobsClient(false)
+	client.partialReplies = true
+	data := []byte("0123456789ABCDEF")
+
+	options := newRetryOptions(2, 1)
+
+	blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, data, options)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, blob) {
+		return
+	}
+
+	// Only the bytes the server did not commit are sent again
+	assert.Equal(t, []string{"0123", "23", "4567", "67", "89AB", "AB"}, client.writes)
+
+	result, _, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "",
+		blob.Id, client, options)
+	assert.Nil(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestRetrySkipsOffsetQueryForAnsweredWrites(t *testing.T) {
+	client := newFlakyBlobsClient(false)
+	client.answered = true
+	data := []byte("0123456789ABCDEF")
+
+	options := newRetryOptions(2, 1)
+
+	blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, data, options)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, blob) {
+		return
+	}
+
+	// The server rejected the writes, so nothing could be committed
+	assert.Equal(t, 0, client.queries)
+	assert.Equal(t, 3, client.failures)
+
+	result, _, err := version1.BlobsDataProcessorV1.GetBlobDataByIdWithOptions(context.Background(), "",
+		blob.Id, client, options)
+	assert.Nil(t, err)
+	assert.Equal(t, data, result)
+}
+
+func TestRetryContinuesWithCommittedToken(t *testing.T) {
+	client := newRotatingBlobsClient()
+	options := newRetryOptions(3, 1)
+	data := []byte("0123456789ABCDEFGHIJ")
+
+	blob, err := version1.BlobsDataProcessorV1.CreateBlobFromDataWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "", "test.dat", 0, ""), client, data, options)
+	assert.Nil(t, err)
+	if !assert.NotNil(t, blob) {
+		return
+	}
+
+	// The lost chunk is not written twice
+	result, _, err := version1.BlobsDataProcessorV1.GetBlobDataById(context.Background(), "", blob.Id, client, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, data, result)
+}
@@ -0,0 +1,178 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeBlobsChunkyReader serves the content as a blob of the given size.
+// Chunks are cut to maxChunk bytes, extended by extra bytes past the requested range
+// and come empty from the cut offset. Missing blobs are not found.
+type fakeBlobsChunkyReader struct {
+	missing  bool
+	content  []byte
+	size     int64
+	maxChunk int
+	extra    int
+	cut      int64
+	ended    bool
+}
+
+func (c *fakeBlobsChunkyReader) BeginBlobRead(ctx context.Context, correlationId string,
+	blobId string) (*version1.BlobInfoV1, error) {
+	if c.missing {
+		return nil, nil
+	}
+	blob := version1.NewBlobInfoV1(blobId, "test", "file.dat", c.size, "")
+	return blob, nil
+}
+
+func (c *fakeBlobsChunkyReader) ReadBlobChunk(ctx context.Context, correlationId string, blobId string,
+	skip int64, take int64) ([]byte, error) {
+	if c.cut > 0 && skip >= c.cut {
+		return []byte{}, nil
+	}
+
+	size := int(take)
+	if c.maxChunk > 0 && size > c.maxChunk {
+		size = c.maxChunk
+	}
+	size += c.extra
+
+	end := int(skip) + size
+	if end > len(c.content) {
+		end = len(c.content)
+	}
+	if int(skip) >= end {
+		return []byte{}, nil
+	}
+	return c.content[skip:end], nil
+}
+
+func (c *fakeBlobsChunkyReader) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
+	c.ended = true
+	return nil
+}
+
+// failingWriter fails every write
+type failingWriter struct {
+	err error
+}
+
+func (c *failingWriter) Write(p []byte) (int, error) {
+	return 0, c.err
+}
+
+func TestGetBlobStreamById(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 5)
+
+	tests := []struct {
+		name      string
+		reader    *fakeBlobsChunkyReader
+		readAhead int
+		truncated int64 // bytes read before the content ended, -1 for complete content
+	}{
+		{"whole chunks", &fakeBlobsChunkyReader{content: content, size: 50}, 1, -1},
+		{"zero length", &fakeBlobsChunkyReader{content: []byte{}, size: 0}, 1, -1},
+		{"short chunks", &fakeBlobsChunkyReader{content: content, size: 50, maxChunk: 3}, 1, -1},
+		{"oversized chunks", &fakeBlobsChunkyReader{content: content, size: 50, extra: 5}, 1, -1},
+		{"empty chunk", &fakeBlobsChunkyReader{content: content, size: 50, cut: 30}, 1, 30},
+		{"content shorter than size", &fakeBlobsChunkyReader{content: content, size: 60}, 1, 50},
+		{"short chunks ahead", &fakeBlobsChunkyReader{content: content, size: 50, maxChunk: 3}, 3, -1},
+		{"oversized chunks ahead", &fakeBlobsChunkyReader{content: content, size: 50, extra: 5}, 3, -1},
+		{"empty chunk ahead", &fakeBlobsChunkyReader{content: content, size: 50, cut: 30}, 3, 30},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := version1.NewBlobsTransferOptionsV1WithChunkSize(10)
+			options.ReadAhead = test.readAhead
+			buffer := &bytes.Buffer{}
+
+			blob, err := version1.BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(context.Background(), "123",
+				"1", test.reader, buffer, options)
+			assert.True(t, test.reader.ended)
+
+			if test.truncated < 0 {
+				assert.Nil(t, err)
+				if assert.NotNil(t, blob) {
+					assert.Equal(t, test.reader.size, blob.Size)
+				}
+				assert.Equal(t, string(test.reader.content[:test.reader.size]), buffer.String())
+				return
+			}
+
+			assert.Nil(t, blob)
+			assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), "unexpected error %v", err)
+
+			var truncated *version1.BlobsTruncatedErrorV1
+			if assert.True(t, errors.As(err, &truncated)) {
+				assert.Equal(t, "123", truncated.CorrelationId)
+				assert.Equal(t, "1", truncated.BlobId)
+				assert.Equal(t, test.reader.size, truncated.Expected)
+				assert.Equal(t, test.truncated, truncated.Actual)
+			}
+
+			var appErr *cerr.ApplicationError
+			if assert.True(t, errors.As(err, &appErr)) {
+				assert.Equal(t, version1.BlobTruncated, appErr.Code)
+			}
+		})
+	}
+}
+
+func TestGetBlobStreamByIdWriteFailure(t *testing.T) {
+	reader := &fakeBlobsChunkyReader{content: []byte("0123456789"), size: 10}
+	writeErr := errors.New("disk is full")
+
+	blob, err := version1.BlobsStreamProcessorV1.GetBlobStreamById(context.Background(), "123",
+		"1", reader, &failingWriter{err: writeErr}, 4)
+	assert.Nil(t, blob)
+	assert.Equal(t, writeErr, err)
+	assert.True(t, reader.ended)
+}
+
+func TestGetBlobRangeStreamById(t *testing.T) {
+	content := bytes.Repeat([]byte("0123456789"), 5)
+
+	tests := []struct {
+		name      string
+		reader    *fakeBlobsChunkyReader
+		truncated int64 // bytes read before the content ended, -1 for complete range
+	}{
+		{"whole chunks", &fakeBlobsChunkyReader{content: content, size: 50}, -1},
+		{"short chunks", &fakeBlobsChunkyReader{content: content, size: 50, maxChunk: 3}, -1},
+		{"oversized chunks", &fakeBlobsChunkyReader{content: content, size: 50, extra: 5}, -1},
+		{"empty chunk", &fakeBlobsChunkyReader{content: content, size: 50, cut: 22}, 22},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buffer := &bytes.Buffer{}
+			blob, err := version1.BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(context.Background(), "123",
+				"1", 12, 20, test.reader, buffer, version1.NewBlobsTransferOptionsV1WithChunkSize(10))
+			assert.True(t, test.reader.ended)
+
+			if test.truncated < 0 {
+				assert.Nil(t, err)
+				assert.NotNil(t, blob)
+				assert.Equal(t, string(content[12:32]), buffer.String())
+				return
+			}
+
+			assert.Nil(t, blob)
+			var truncated *version1.BlobsTruncatedErrorV1
+			if assert.True(t, errors.As(err, &truncated)) {
+				assert.Equal(t, int64(32), truncated.Expected)
+				assert.Equal(t, test.truncated, truncated.Actual)
+			}
+		})
+	}
+}
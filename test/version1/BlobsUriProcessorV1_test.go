@@ -0,0 +1,192 @@
+package test_version1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/pip-services-infrastructure2/client-blobs-go/version1"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+var uriTestContent = bytes.Repeat([]byte("0123456789"), 100)
+
+func newUriTestServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/files/report.pdf", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Header().Set("Content-Length", strconv.Itoa(len(uriTestContent)))
+		w.Write(uriTestContent)
+	})
+	mux.HandleFunc("/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Disposition", `attachment; filename="summary.txt"`)
+		w.Write([]byte("summary"))
+	})
+	mux.HandleFunc("/stream", func(w http.ResponseWriter, r *http.Request) {
+		// Chunked response without length
+		for i := 0; i < 10; i++ {
+			w.Write(uriTestContent[:100])
+			w.(http.Flusher).Flush()
+		}
+	})
+	mux.HandleFunc("/failure", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Internal error page", http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/files/report.pdf", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	})
+	return httptest.NewServer(mux)
+}
+
+func assertErrorCode(t *testing.T, code string, err error) {
+	var appErr *cerr.ApplicationError
+	if assert.True(t, errors.As(err, &appErr), "expected application error, got %v", err) {
+		assert.Equal(t, code, appErr.Code)
+	}
+}
+
+func createBlobFromTestUri(ctx context.Context, writer version1.IBlobsChunkyWriterV1, uri string,
+	options *version1.BlobsTransferOptionsV1) (*version1.BlobInfoV1, error) {
+	return version1.BlobsUriProcessorV1.CreateBlobFromUriWithOptions(ctx, "",
+		version1.NewBlobInfoV1("", "test", "", 0, ""), writer, uri, options)
+}
+
+func TestUriCreateBlob(t *testing.T) {
+	server := newUriTestServer()
+	defer server.Close()
+	client := version1.NewBlobsMockClientV1()
+	options := version1.NewBlobsTransferOptionsV1WithChunkSize(64)
+
+	blob, err := createBlobFromTestUri(context.Background(), client, server.URL+"/files/report.pdf", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "report.pdf", blob.Name)
+	assert.Equal(t, "application/pdf", blob.ContentType)
+	assert.Equal(t, int64(len(uriTestContent)), blob.Size)
+
+	result, _, err := client.GetBlobDataById(context.Background(), "", blob.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, uriTestContent, result)
+
+	// Name from Content-Disposition
+	blob, err = createBlobFromTestUri(context.Background(), client, server.URL+"/download?id=1", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "summary.txt", blob.Name)
+	assert.Equal(t, "text/plain; charset=utf-8", blob.ContentType)
+
+	// Given name is kept
+	blob, err = version1.BlobsUriProcessorV1.CreateBlobFromUriWithOptions(context.Background(), "",
+		version1.NewBlobInfoV1("", "test", "custom.pdf", 0, ""), client, server.URL+"/download", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "custom.pdf", blob.Name)
+}
+
+func TestUriRejectsFailedResponses(t *testing.T) {
+	server := newUriTestServer()
+	defer server.Close()
+	writer := &countingBlobsWriter{BlobsMockClientV1: version1.NewBlobsMockClientV1()}
+	options := version1.NewBlobsTransferOptionsV1()
+
+	_, err := createBlobFromTestUri(context.Background(), writer, server.URL+"/missing", options)
+	assertErrorCode(t, "URI_NOT_FOUND", err)
+
+	_, err = createBlobFromTestUri(context.Background(), writer, server.URL+"/failure", options)
+	assertErrorCode(t, "URI_DOWNLOAD_FAILED", err)
+
+	_, err = createBlobFromTestUri(context.Background(), writer, "ftp://localhost/file.txt", options)
+	assertErrorCode(t, "INVALID_URI", err)
+
+	// Error pages are never uploaded
+	assert.Equal(t, 0, writer.begins)
+}
+
+func TestUriRedirects(t *testing.T) {
+	server := newUriTestServer()
+	defer server.Close()
+	writer := &countingBlobsWriter{BlobsMockClientV1: version1.NewBlobsMockClientV1()}
+	options := version1.NewBlobsTransferOptionsV1()
+
+	// Name is taken from the final location
+	blob, err := createBlobFromTestUri(context.Background(), writer, server.URL+"/moved", options)
+	assert.Nil(t, err)
+	assert.Equal(t, "report.pdf", blob.Name)
+
+	_, err = createBlobFromTestUri(context.Background(), writer, server.URL+"/loop", options)
+	assertErrorCode(t, "TOO_MANY_REDIRECTS", err)
+
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.uri.max_redirects", 0,
+	))
+	_, err = createBlobFromTestUri(context.Background(), writer, server.URL+"/moved", options)
+	assertErrorCode(t, "TOO_MANY_REDIRECTS", err)
+
+	assert.Equal(t, 1, writer.begins)
+}
+
+func TestUriMaxSize(t *testing.T) {
+	server := newUriTestServer()
+	defer server.Close()
+	writer := &countingBlobsWriter{BlobsMockClientV1: version1.NewBlobsMockClientV1()}
+	options := version1.NewBlobsTransferOptionsV1WithChunkSize(64)
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.uri.max_size", 500,
+	))
+
+	// Rejected by Content-Length before upload
+	_, err := createBlobFromTestUri(context.Background(), writer, server.URL+"/files/report.pdf", options)
+	assertErrorCode(t, "BLOB_TOO_LARGE", err)
+	assert.Equal(t, 0, writer.begins)
+
+	// Stopped while content is downloaded
+	_, err = createBlobFromTestUri(context.Background(), writer, server.URL+"/stream", options)
+	assertErrorCode(t, "BLOB_TOO_LARGE", err)
+
+	page, err := writer.GetBlobsByFilter(context.Background(), "", nil, nil)
+	assert.Nil(t, err)
+	assert.Len(t, page.Data, 0)
+
+	_, err = createBlobFromTestUri(context.Background(), writer, server.URL+"/download", options)
+	assert.Nil(t, err)
+}
+
+func TestUriTimeouts(t *testing.T) {
+	server := newUriTestServer()
+	defer server.Close()
+	client := version1.NewBlobsMockClientV1()
+	options := version1.NewBlobsTransferOptionsV1()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := createBlobFromTestUri(ctx, client, server.URL+"/slow", options)
+	assert.NotNil(t, err)
+	assert.Less(t, time.Since(start), 3*time.Second)
+
+	options.Configure(context.Background(), config.NewConfigParamsFromTuples(
+		"options.uri.timeout", 100,
+	))
+	start = time.Now()
+	_, err = createBlobFromTestUri(context.Background(), client, server.URL+"/slow", options)
+	assertErrorCode(t, "URI_DOWNLOAD_FAILED", err)
+	assert.Less(t, time.Since(start), 3*time.Second)
+
+	// Custom client
+	options.Uri.HttpClient = &http.Client{Timeout: 100 * time.Millisecond}
+	_, err = createBlobFromTestUri(context.Background(), client, server.URL+"/slow", options)
+	assertErrorCode(t, "URI_DOWNLOAD_FAILED", err)
+}
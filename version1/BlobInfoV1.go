@@ -16,6 +16,17 @@ type BlobInfoV1 struct {
 	CreateTime  time.Time `json:"create_time"`
 	ExpireTime  time.Time `json:"expire_time"`
 	Completed   bool      `json:"completed"`
+
+	/* Custom data */
+	Metadata map[string]string `json:"metadata"`
+	Tags     []string          `json:"tags"`
+
+	/* Integrity */
+	ChecksumAlgorithm string `json:"checksum_algorithm"`
+	Checksum          string `json:"checksum"`
+
+	/* Compression of the stored content: gzip, zstd or empty */
+	ContentEncoding string `json:"content_encoding"`
 }
 
 func EmptyBlobInfoV1() *BlobInfoV1 {
@@ -0,0 +1,8 @@
+package version1
+
+// BlobWriteOffsetV1 is the committed size of an unfinished upload
+// together with the token to continue it
+type BlobWriteOffsetV1 struct {
+	Offset int64  `json:"offset"`
+	Token  string `json:"token"`
+}
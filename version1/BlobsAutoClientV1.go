@@ -0,0 +1,507 @@
+package version1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	cref "github.com/pip-services3-gox/pip-services3-commons-gox/refer"
+)
+
+const (
+	BlobsTransportGrpc            = "grpc"
+	BlobsTransportCommandableGrpc = "commandable-grpc"
+	BlobsTransportCommandableHttp = "commandable-http"
+)
+
+type blobsTransportClient interface {
+	IBlobsClientV1
+	IBlobsRangeReaderV1
+	IBlobsSortedReaderV1
+	IBlobsChunkyReaderV1
+	IBlobsChunkyWriterV1
+	cconf.IConfigurable
+	Open(ctx context.Context, correlationId string) error
+	Close(ctx context.Context, correlationId string) error
+	CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+		stream io.ReadSeeker) (*BlobInfoV1, error)
+	OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error)
+	OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error)
+	ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1
+}
+
+// BlobsAutoClientV1 probes the blobs service at Open and selects the best available transport:
+// native gRPC with streaming, then commandable gRPC, then commandable HTTP.
+// Native gRPC without streaming is selected only when no other transport responds.
+//
+//	Configuration parameters:
+//		- connection(s): connection shared by all transports
+//		- transports:
+//			- grpc.*: settings that override the shared ones for native gRPC
+//			- commandable-grpc.*: settings that override the shared ones for commandable gRPC
+//			- commandable-http.*: settings that override the shared ones for commandable HTTP
+//		- options:
+//			- transports: comma-separated transports in the order of preference (default: grpc,commandable-grpc,commandable-http)
+//			- probe_timeout: timeout to probe a single transport in milliseconds (default: 5000)
+type BlobsAutoClientV1 struct {
+	config       *cconf.ConfigParams
+	references   cref.IReferences
+	transports   []string
+	probeTimeout time.Duration
+	lock         sync.Mutex
+	client       blobsTransportClient
+	transport    string
+}
+
+func NewBlobsAutoClientV1() *BlobsAutoClientV1 {
+	return &BlobsAutoClientV1{
+		config:       cconf.NewEmptyConfigParams(),
+		transports:   []string{BlobsTransportGrpc, BlobsTransportCommandableGrpc, BlobsTransportCommandableHttp},
+		probeTimeout: 5000 * time.Millisecond,
+	}
+}
+
+func (c *BlobsAutoClientV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.config = config
+
+	transports := config.GetAsString("options.transports")
+	if transports != "" {
+		c.transports = make([]string, 0)
+		for _, transport := range strings.Split(transports, ",") {
+			transport = strings.TrimSpace(transport)
+			if transport != "" {
+				c.transports = append(c.transports, transport)
+			}
+		}
+	}
+
+	c.probeTimeout = time.Duration(config.GetAsLongWithDefault("options.probe_timeout",
+		c.probeTimeout.Milliseconds())) * time.Millisecond
+}
+
+func (c *BlobsAutoClientV1) SetReferences(ctx context.Context, references cref.IReferences) {
+	c.references = references
+}
+
+func (c *BlobsAutoClientV1) IsOpen() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.client != nil
+}
+
+// Transport returns the name of the selected transport or "" when the client is not opened
+func (c *BlobsAutoClientV1) Transport() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.transport
+}
+
+// Client returns the client for the selected transport
+func (c *BlobsAutoClientV1) Client() IBlobsClientV1 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.client == nil {
+		return nil
+	}
+	return c.client
+}
+
+func (c *BlobsAutoClientV1) Open(ctx context.Context, correlationId string) error {
+	if c.IsOpen() {
+		return nil
+	}
+
+	// Probes may take long, so the lock is held only to publish the selected client
+	client, transport, err := c.selectTransport(ctx, correlationId)
+	if err != nil {
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Another Open was faster
+	if c.client != nil {
+		client.Close(ctx, correlationId)
+		return nil
+	}
+
+	c.client = client
+	c.transport = transport
+	return nil
+}
+
+func (c *BlobsAutoClientV1) Close(ctx context.Context, correlationId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+
+	err := c.client.Close(ctx, correlationId)
+	c.client = nil
+	c.transport = ""
+	return err
+}
+
+func (c *BlobsAutoClientV1) newClient(correlationId string, transport string) (blobsTransportClient, error) {
+	switch transport {
+	case BlobsTransportGrpc:
+		return NewBlobGrpcClientV1(), nil
+	case BlobsTransportCommandableGrpc:
+		return NewBlobsCommandableGrpcClientV1(), nil
+	case BlobsTransportCommandableHttp:
+		return NewBlobsCommandableHttpClientV1(), nil
+	default:
+		return nil, cerr.NewConfigError(correlationId, "UNKNOWN_TRANSPORT",
+			"Blobs transport "+transport+" is not supported").WithDetails("transport", transport)
+	}
+}
+
+// selectTransport probes the transports in the configured order.
+// Native gRPC without streaming is kept only when no other transport responds.
+func (c *BlobsAutoClientV1) selectTransport(ctx context.Context,
+	correlationId string) (blobsTransportClient, string, error) {
+
+	var fallback blobsTransportClient
+	fallbackTransport := ""
+	var lastErr error
+	for _, transport := range c.transports {
+		client, preferred, err := c.probe(ctx, correlationId, transport)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		if preferred {
+			if fallback != nil {
+				fallback.Close(ctx, correlationId)
+			}
+			return client, transport, nil
+		}
+		if fallback != nil {
+			client.Close(ctx, correlationId)
+			continue
+		}
+		fallback = client
+		fallbackTransport = transport
+	}
+	if fallback != nil {
+		return fallback, fallbackTransport, nil
+	}
+
+	err := cerr.NewConnectionError(correlationId, "NO_TRANSPORT",
+		"Blobs service is not available through any of "+strings.Join(c.transports, ", ")).
+		WithDetails("transports", c.transports)
+	if lastErr != nil {
+		err = err.WithCause(lastErr)
+	}
+	return nil, "", err
+}
+
+// probe opens the client for the transport and checks that the service responds through it.
+// Native gRPC is preferred only when the service advertises streaming.
+func (c *BlobsAutoClientV1) probe(ctx context.Context, correlationId string,
+	transport string) (client blobsTransportClient, preferred bool, err error) {
+
+	client, err = c.newClient(correlationId, transport)
+	if err != nil {
+		return nil, false, err
+	}
+
+	client.Configure(ctx, c.config.Override(c.config.GetSection("transports."+transport)))
+	if referenceable, ok := client.(cref.IReferenceable); ok && c.references != nil {
+		referenceable.SetReferences(ctx, c.references)
+	}
+
+	// Connections opened before a failure are released
+	err = client.Open(ctx, correlationId)
+	if err != nil {
+		client.Close(ctx, correlationId)
+		return nil, false, err
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, c.probeTimeout)
+	defer cancel()
+
+	// A hanging request means the service does not speak this protocol
+	blobId := data.IdGenerator.NextLong()
+	_, err = client.GetBlobById(probeCtx, correlationId, blobId)
+	if probeCtx.Err() != nil && err != nil {
+		err = cerr.NewConnectionError(correlationId, "PROBE_TIMEOUT",
+			"Blobs service did not respond through "+transport).WithCause(err)
+	}
+	if !c.isReachable(err, blobId) {
+		client.Close(ctx, correlationId)
+		return nil, false, err
+	}
+
+	preferred = true
+	if grpcClient, ok := client.(*BlobGrpcClientV1); ok {
+		preferred = grpcClient.supportsStreaming(probeCtx, correlationId, BlobsFeatureUploadBlob) &&
+			grpcClient.supportsStreaming(probeCtx, correlationId, BlobsFeatureDownloadBlob)
+	}
+	return client, preferred, nil
+}
+
+// isReachable accepts only a response of the blobs service itself: the probe blob is
+// not found or found without an error. Other errors may come from a wrong route or a proxy.
+func (c *BlobsAutoClientV1) isReachable(err error, blobId string) bool {
+	if err == nil {
+		return true
+	}
+
+	var appErr *cerr.ApplicationError
+	if !errors.As(err, &appErr) {
+		return false
+	}
+	return appErr.Category == cerr.NotFound && appErr.Code == "BLOB_NOT_FOUND" &&
+		appErr.Details["blob_id"] == blobId
+}
+
+func (c *BlobsAutoClientV1) getClient(correlationId string) (blobsTransportClient, error) {
+	c.lock.Lock()
+	client := c.client
+	c.lock.Unlock()
+
+	if client == nil {
+		return nil, cerr.NewInvalidStateError(correlationId, "NOT_OPENED", "Blobs client is not opened")
+	}
+	return client, nil
+}
+
+func (c *BlobsAutoClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return *data.NewEmptyDataPage[*BlobInfoV1](), err
+	}
+	return client.GetBlobsByFilter(ctx, correlationId, filter, paging)
+}
+
+func (c *BlobsAutoClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return *data.NewEmptyDataPage[*BlobInfoV1](), err
+	}
+	return client.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, sort)
+}
+
+func (c *BlobsAutoClientV1) GetBlobsByIds(ctx context.Context, correlationId string, blobIds []string) (result []*BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlobsByIds(ctx, correlationId, blobIds)
+}
+
+func (c *BlobsAutoClientV1) GetBlobById(ctx context.Context, correlationId string, blobId string) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetBlobById(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	uri string) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateBlobFromUri(ctx, correlationId, blob, uri)
+}
+
+func (c *BlobsAutoClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return "", err
+	}
+	return client.GetBlobUriById(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	buffer []byte) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateBlobFromData(ctx, correlationId, blob, buffer)
+}
+
+func (c *BlobsAutoClientV1) GetBlobDataById(ctx context.Context, correlationId string,
+	blobId string) (result []byte, blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetBlobDataById(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	stream io.Reader) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateBlobFromStream(ctx, correlationId, blob, stream)
+}
+
+func (c *BlobsAutoClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string,
+	stream io.Writer) (blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadBlobStreamById(ctx, correlationId, blobId, stream)
+}
+
+func (c *BlobsAutoClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return client.GetBlobRangeById(ctx, correlationId, blobId, offset, length)
+}
+
+func (c *BlobsAutoClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadBlobRangeStreamById(ctx, correlationId, blobId, offset, length, stream)
+}
+
+func (c *BlobsAutoClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.UpdateBlobInfo(ctx, correlationId, blob)
+}
+
+func (c *BlobsAutoClientV1) MarkBlobsCompleted(ctx context.Context, correlationId string, blobIds []string) error {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return err
+	}
+	return client.MarkBlobsCompleted(ctx, correlationId, blobIds)
+}
+
+func (c *BlobsAutoClientV1) DeleteBlobById(ctx context.Context, correlationId string, blobId string) error {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return err
+	}
+	return client.DeleteBlobById(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) DeleteBlobsByIds(ctx context.Context, correlationId string, blobIds []string) error {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return err
+	}
+	return client.DeleteBlobsByIds(ctx, correlationId, blobIds)
+}
+
+func (c *BlobsAutoClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+	stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.CreateBlobFromStreamResumable(ctx, correlationId, key, blob, stream)
+}
+
+func (c *BlobsAutoClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.OpenBlobReader(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.OpenBlobWriter(ctx, correlationId, blob)
+}
+
+func (c *BlobsAutoClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		// The iterator reports the error on the first page
+		return NewBlobsIteratorV1(ctx, correlationId, c, filter, nil)
+	}
+	return client.ListBlobs(ctx, correlationId, filter)
+}
+
+func (c *BlobsAutoClientV1) BeginBlobRead(ctx context.Context, correlationId string, blobId string) (blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.BeginBlobRead(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) ReadBlobChunk(ctx context.Context, correlationId string, blobId string,
+	skip int64, take int64) (chunk []byte, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+}
+
+func (c *BlobsAutoClientV1) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return err
+	}
+	return client.EndBlobRead(ctx, correlationId, blobId)
+}
+
+func (c *BlobsAutoClientV1) BeginBlobWrite(ctx context.Context, correlationId string, blob *BlobInfoV1) (token string, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return "", err
+	}
+	return client.BeginBlobWrite(ctx, correlationId, blob)
+}
+
+func (c *BlobsAutoClientV1) WriteBlobChunk(ctx context.Context, correlationId string, token string,
+	chunk []byte) (token2 string, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return "", err
+	}
+	return client.WriteBlobChunk(ctx, correlationId, token, chunk)
+}
+
+func (c *BlobsAutoClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string,
+	chunk []byte) (blob *BlobInfoV1, err error) {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return nil, err
+	}
+	return client.EndBlobWrite(ctx, correlationId, token, chunk)
+}
+
+func (c *BlobsAutoClientV1) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
+	client, err := c.getClient(correlationId)
+	if err != nil {
+		return err
+	}
+	return client.AbortBlobWrite(ctx, correlationId, token)
+}
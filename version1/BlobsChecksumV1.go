@@ -0,0 +1,158 @@
+package version1
+
+import (
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"strings"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+const (
+	ChecksumNone   = "none"
+	ChecksumMd5    = "md5"
+	ChecksumSha256 = "sha256"
+)
+
+const BlobChecksumMismatch = "BLOB_CHECKSUM_MISMATCH"
+
+// BlobsIntegrityErrorV1 is returned when blob content does not match its checksum
+type BlobsIntegrityErrorV1 struct {
+	*cerr.ApplicationError
+	BlobId    string
+	Algorithm string
+	Expected  string
+	Actual    string
+}
+
+func NewBlobsIntegrityErrorV1(correlationId string, blobId string, algorithm string,
+	expected string, actual string) *BlobsIntegrityErrorV1 {
+
+	err := cerr.NewConflictError(correlationId, BlobChecksumMismatch,
+		"Content of blob "+blobId+" does not match its "+algorithm+" checksum").
+		WithDetails("blob_id", blobId).
+		WithDetails("algorithm", algorithm).
+		WithDetails("expected", expected).
+		WithDetails("actual", actual)
+
+	return &BlobsIntegrityErrorV1{
+		ApplicationError: err,
+		BlobId:           blobId,
+		Algorithm:        algorithm,
+		Expected:         expected,
+		Actual:           actual,
+	}
+}
+
+func (e *BlobsIntegrityErrorV1) Unwrap() error {
+	return e.ApplicationError
+}
+
+// ComputeBlobChecksumV1 calculates hex digest of the data, or returns "" for unsupported algorithms
+func ComputeBlobChecksumV1(algorithm string, data []byte) string {
+	checksum := newBlobsChecksum(algorithm)
+	checksum.Write(data)
+	return checksum.sum()
+}
+
+// blobsChecksum calculates blob digest while content passes through the processors.
+// A nil checksum is valid and does nothing.
+type blobsChecksum struct {
+	algorithm string
+	hash      hash.Hash
+}
+
+func newBlobsChecksum(algorithm string) *blobsChecksum {
+	algorithm = strings.ToLower(algorithm)
+	switch algorithm {
+	case ChecksumMd5:
+		return &blobsChecksum{algorithm: algorithm, hash: md5.New()}
+	case ChecksumSha256:
+		return &blobsChecksum{algorithm: algorithm, hash: sha256.New()}
+	default:
+		return nil
+	}
+}
+
+// newBlobsChecksumForRead returns a checksum to verify the blob, or nil if the blob has no digest
+func newBlobsChecksumForRead(blob *BlobInfoV1) *blobsChecksum {
+	if blob == nil || blob.Checksum == "" {
+		return nil
+	}
+	return newBlobsChecksum(blob.ChecksumAlgorithm)
+}
+
+func (c *blobsChecksum) Write(data []byte) (int, error) {
+	if c != nil {
+		c.hash.Write(data)
+	}
+	return len(data), nil
+}
+
+func (c *blobsChecksum) sum() string {
+	if c == nil {
+		return ""
+	}
+	return hex.EncodeToString(c.hash.Sum(nil))
+}
+
+// prepare tells the service which algorithm is used for the blob
+func (c *blobsChecksum) prepare(blob *BlobInfoV1) {
+	if c != nil && blob != nil {
+		blob.ChecksumAlgorithm = c.algorithm
+		blob.Checksum = ""
+	}
+}
+
+// complete compares the digest of uploaded content with the one calculated by the service.
+// When the service did not keep the digest it is saved through UpdateBlobInfo if the writer supports it.
+func (c *blobsChecksum) complete(ctx context.Context, correlationId string, writer any,
+	blob *BlobInfoV1) (*BlobInfoV1, error) {
+
+	if c == nil || blob == nil {
+		return blob, nil
+	}
+
+	checksum := c.sum()
+	if blob.Checksum != "" && strings.EqualFold(blob.ChecksumAlgorithm, c.algorithm) {
+		if !strings.EqualFold(blob.Checksum, checksum) {
+			return nil, NewBlobsIntegrityErrorV1(correlationId, blob.Id, c.algorithm, checksum, blob.Checksum)
+		}
+		return blob, nil
+	}
+
+	blob.ChecksumAlgorithm = c.algorithm
+	blob.Checksum = checksum
+
+	updater, ok := writer.(interface {
+		UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobInfoV1, error)
+	})
+	if !ok {
+		return blob, nil
+	}
+
+	result, err := updater.UpdateBlobInfo(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		result = blob
+	}
+	return result, nil
+}
+
+// verify checks the content that was read against the blob digest
+func (c *blobsChecksum) verify(correlationId string, blob *BlobInfoV1) error {
+	if c == nil {
+		return nil
+	}
+
+	checksum := c.sum()
+	if !strings.EqualFold(blob.Checksum, checksum) {
+		return NewBlobsIntegrityErrorV1(correlationId, blob.Id, c.algorithm, blob.Checksum, checksum)
+	}
+	return nil
+}
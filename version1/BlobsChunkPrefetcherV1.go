@@ -0,0 +1,154 @@
+package version1
+
+import (
+	"context"
+	"io"
+)
+
+type blobsChunkResult struct {
+	chunk []byte
+	err   error
+}
+
+type blobsChunkRequest struct {
+	take   int64
+	result chan blobsChunkResult
+}
+
+// blobsChunkPrefetcher reads blob chunks through IBlobsChunkyReaderV1 ahead of
+// the consumer, keeping up to window requests in flight, and returns them in order.
+type blobsChunkPrefetcher struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	correlationId string
+	reader        IBlobsChunkyReaderV1
+	retries       *BlobsRetryPolicyV1
+	limiter       *BlobsRateLimiterV1
+	blobId        string
+	size          int64
+	chunkSize     int64
+	window        int
+	skip          int64
+	pending       []blobsChunkRequest
+}
+
+func newBlobsChunkPrefetcher(ctx context.Context, correlationId string, reader IBlobsChunkyReaderV1,
+	blobId string, size int64, options *BlobsTransferOptionsV1) *blobsChunkPrefetcher {
+
+	window := options.getReadAhead()
+	ctx, cancel := context.WithCancel(ctx)
+	c := &blobsChunkPrefetcher{
+		ctx:           ctx,
+		cancel:        cancel,
+		correlationId: correlationId,
+		reader:        reader,
+		retries:       options.getRetries(),
+		limiter:       options.getDownloadLimiter(),
+		blobId:        blobId,
+		size:          size,
+		chunkSize:     int64(options.getChunkSize()),
+		window:        window,
+		pending:       make([]blobsChunkRequest, 0, window),
+	}
+
+	for len(c.pending) < c.window && c.schedule() {
+	}
+
+	return c
+}
+
+// schedule starts reading the next chunk in background
+func (c *blobsChunkPrefetcher) schedule() bool {
+	if c.skip >= c.size {
+		return false
+	}
+
+	skip := c.skip
+	take := c.chunkSize
+	if take > c.size-skip {
+		take = c.size - skip
+	}
+	c.skip += take
+
+	result := make(chan blobsChunkResult, 1)
+	c.pending = append(c.pending, blobsChunkRequest{take: take, result: result})
+
+	go func() {
+		chunk, err := c.read(skip, take)
+		result <- blobsChunkResult{chunk: chunk, err: err}
+	}()
+
+	return true
+}
+
+// read gets exactly take bytes unless the reader runs out of data
+func (c *blobsChunkPrefetcher) read(skip int64, take int64) ([]byte, error) {
+	buffer := make([]byte, 0, take)
+
+	if err := c.limiter.Wait(c.ctx, int(take)); err != nil {
+		return nil, err
+	}
+
+	for int64(len(buffer)) < take {
+		chunk, err := c.retries.readChunk(c.ctx, c.correlationId, c.reader, c.blobId,
+			skip+int64(len(buffer)), take-int64(len(buffer)), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// Protection against infinite loop
+		if len(chunk) == 0 {
+			break
+		}
+		// Bytes beyond the requested range belong to the following chunks
+		if left := take - int64(len(buffer)); int64(len(chunk)) > left {
+			chunk = chunk[:left]
+		}
+		buffer = append(buffer, chunk...)
+	}
+
+	return buffer, nil
+}
+
+// next returns the following chunk or io.EOF when the blob was read completely
+func (c *blobsChunkPrefetcher) next() ([]byte, error) {
+	if len(c.pending) == 0 {
+		return nil, io.EOF
+	}
+
+	request := c.pending[0]
+	c.pending = c.pending[1:]
+
+	// Cancellation does not wait for the read in progress
+	var result blobsChunkResult
+	select {
+	case result = <-request.result:
+	case <-c.ctx.Done():
+		c.stop()
+		return nil, c.ctx.Err()
+	}
+	if result.err != nil {
+		c.stop()
+		return nil, result.err
+	}
+
+	// A short chunk means the blob ended earlier than expected
+	if int64(len(result.chunk)) < request.take {
+		c.stop()
+	} else {
+		c.schedule()
+	}
+
+	return result.chunk, nil
+}
+
+// stop cancels outstanding reads, so next returns io.EOF
+func (c *blobsChunkPrefetcher) stop() {
+	c.cancel()
+	c.pending = c.pending[:0]
+	c.skip = c.size
+}
+
+func (c *blobsChunkPrefetcher) close() {
+	c.cancel()
+}
@@ -0,0 +1,69 @@
+package version1
+
+import (
+	"time"
+)
+
+// blobsChunkSizer adapts size of chunks to the connection during a single transfer.
+// It starts from the minimum size, doubles the size while chunks take less than
+// half of the target round-trip time and halves it when they are slower than the target
+// or fail. Nil sizer keeps the configured chunk size.
+type blobsChunkSizer struct {
+	size    int
+	minSize int
+	maxSize int
+	target  time.Duration
+}
+
+func newBlobsChunkSizer(options *BlobsTransferOptionsV1) *blobsChunkSizer {
+	if options == nil || !options.AdaptiveChunks {
+		return nil
+	}
+
+	minSize, maxSize := options.getChunkSizeRange()
+	return &blobsChunkSizer{
+		size:    minSize,
+		minSize: minSize,
+		maxSize: maxSize,
+		target:  options.getChunkRtt(),
+	}
+}
+
+// next returns size of the following chunk
+func (c *blobsChunkSizer) next(chunkSize int) int {
+	if c == nil {
+		return chunkSize
+	}
+	return c.size
+}
+
+// bufferSize returns size of the buffer to hold the largest chunk
+func (c *blobsChunkSizer) bufferSize(chunkSize int) int {
+	if c == nil {
+		return chunkSize
+	}
+	return c.maxSize
+}
+
+// observe adjusts the size after a single attempt to transfer a chunk of size bytes
+func (c *blobsChunkSizer) observe(size int, rtt time.Duration, err error) {
+	if c == nil {
+		return
+	}
+
+	if err != nil || rtt > c.target {
+		c.size /= 2
+		if c.size < c.minSize {
+			c.size = c.minSize
+		}
+		return
+	}
+
+	// Short chunks at the end of content say nothing about the connection
+	if size >= c.size && rtt < c.target/2 {
+		c.size *= 2
+		if c.size > c.maxSize {
+			c.size = c.maxSize
+		}
+	}
+}
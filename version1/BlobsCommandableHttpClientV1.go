@@ -11,7 +11,8 @@ import (
 
 type BlobsCommandableHttpClientV1 struct {
 	*clients.CommandableHttpClient
-	chunkSize int64
+	options  *BlobsTransferOptionsV1
+	features blobsFeatures
 }
 
 func NewBlobsCommandableHttpClientV1() *BlobsCommandableHttpClientV1 {
@@ -21,7 +22,7 @@ func NewBlobsCommandableHttpClientV1() *BlobsCommandableHttpClientV1 {
 func NewBlobsCommandableHttpClientV1WithConfig(config *cconf.ConfigParams) *BlobsCommandableHttpClientV1 {
 	c := &BlobsCommandableHttpClientV1{
 		CommandableHttpClient: clients.NewCommandableHttpClient("v1/blobs"),
-		chunkSize:             10240,
+		options:               NewBlobsTransferOptionsV1(),
 	}
 
 	if config != nil {
@@ -33,14 +34,37 @@ func NewBlobsCommandableHttpClientV1WithConfig(config *cconf.ConfigParams) *Blob
 
 func (c *BlobsCommandableHttpClientV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
 	c.CommandableHttpClient.Configure(ctx, config)
-	c.chunkSize = config.GetAsLongWithDefault("options.chunk_size", c.chunkSize)
+	c.options.Configure(ctx, config)
+}
+
+func (c *BlobsCommandableHttpClientV1) Close(ctx context.Context, correlationId string) error {
+	// Features are probed again on the next connection
+	c.features.reset()
+
+	return c.CommandableHttpClient.Close(ctx, correlationId)
+}
+
+func (c *BlobsCommandableHttpClientV1) GetFeatures(ctx context.Context, correlationId string) (result []string, err error) {
+	res, err := c.CallCommand(ctx, "get_features", correlationId, data.NewEmptyAnyValueMap())
+	if err != nil {
+		return nil, err
+	}
+
+	return clients.HandleHttpResponse[[]string](res, correlationId)
 }
 
 func (c *BlobsCommandableHttpClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams, paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
+	return c.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, nil)
+}
+
+func (c *BlobsCommandableHttpClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams, paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
 	params := data.NewAnyValueMapFromTuples(
 		"filter", filter,
 		"paging", paging,
 	)
+	if sort != nil && len(*sort) > 0 {
+		params.Put("sort", sort)
+	}
 
 	res, err := c.CallCommand(ctx, "get_blobs_by_filter", correlationId, params)
 	if err != nil {
@@ -78,7 +102,7 @@ func (c *BlobsCommandableHttpClientV1) GetBlobById(ctx context.Context, correlat
 
 func (c *BlobsCommandableHttpClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	uri string) (result *BlobInfoV1, err error) {
-	return BlobsUriProcessorV1.CreateBlobFromUri(ctx, correlationId, blob, c, uri, int(c.chunkSize))
+	return BlobsUriProcessorV1.CreateBlobFromUriWithOptions(ctx, correlationId, blob, c, uri, c.options)
 }
 
 func (c *BlobsCommandableHttpClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
@@ -95,19 +119,47 @@ func (c *BlobsCommandableHttpClientV1) GetBlobUriById(ctx context.Context, corre
 }
 
 func (c *BlobsCommandableHttpClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1, buffer []byte) (result *BlobInfoV1, err error) {
-	return BlobsDataProcessorV1.CreateBlobFromData(ctx, correlationId, blob, c, buffer, int(c.chunkSize))
+	return BlobsDataProcessorV1.CreateBlobFromDataWithOptions(ctx, correlationId, blob, c, buffer, c.options)
 }
 
 func (c *BlobsCommandableHttpClientV1) GetBlobDataById(ctx context.Context, correlationId string, blobId string) (result []byte, blob *BlobInfoV1, err error) {
-	return BlobsDataProcessorV1.GetBlobDataById(ctx, correlationId, blobId, c, int(c.chunkSize))
+	return BlobsDataProcessorV1.GetBlobDataByIdWithOptions(ctx, correlationId, blobId, c, c.options)
 }
 
 func (c *BlobsCommandableHttpClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1, stream io.Reader) (result *BlobInfoV1, err error) {
-	return BlobsStreamProcessorV1.CreateBlobFromStream(ctx, correlationId, blob, c, stream, int(c.chunkSize))
+	return BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, c, stream, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+	stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.CreateBlobFromStreamResumable(ctx, correlationId, key, blob, c, stream, c.options)
 }
 
 func (c *BlobsCommandableHttpClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string, stream io.Writer) (blob *BlobInfoV1, err error) {
-	return BlobsStreamProcessorV1.GetBlobStreamById(ctx, correlationId, blobId, c, stream, int(c.chunkSize))
+	return BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, c, stream, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.GetBlobRangeByIdWithOptions(ctx, correlationId, blobId, offset, length, c, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, correlationId, blobId, offset, length,
+		c, stream, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobWriter(ctx, correlationId, blob, c, c.options)
+}
+
+func (c *BlobsCommandableHttpClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, c.options)
 }
 
 func (c *BlobsCommandableHttpClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
@@ -180,6 +232,23 @@ func (c *BlobsCommandableHttpClientV1) WriteBlobChunk(ctx context.Context, corre
 	return clients.HandleHttpResponse[string](res, correlationId)
 }
 
+// SupportsBlobParts checks if the server advertises write_blob_part
+func (c *BlobsCommandableHttpClientV1) SupportsBlobParts(ctx context.Context, correlationId string) bool {
+	return c.features.supports(ctx, correlationId, BlobsFeatureWriteBlobPart, c.GetFeatures)
+}
+
+func (c *BlobsCommandableHttpClientV1) WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) error {
+	params := data.NewAnyValueMapFromTuples(
+		"token", token,
+		"offset", offset,
+		"chunk", chunk,
+	)
+
+	_, err := c.CallCommand(ctx, "write_blob_part", correlationId, params)
+
+	return err
+}
+
 func (c *BlobsCommandableHttpClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string, chunk []byte) (blob *BlobInfoV1, err error) {
 	params := data.NewAnyValueMapFromTuples(
 		"token", token,
@@ -204,6 +273,23 @@ func (c *BlobsCommandableHttpClientV1) AbortBlobWrite(ctx context.Context, corre
 	return err
 }
 
+func (c *BlobsCommandableHttpClientV1) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (offset int64, token2 string, err error) {
+	params := data.NewAnyValueMapFromTuples(
+		"token", token,
+	)
+
+	res, err := c.CallCommand(ctx, "get_blob_write_offset", correlationId, params)
+	if err != nil {
+		return 0, "", err
+	}
+
+	result, err := clients.HandleHttpResponse[*BlobWriteOffsetV1](res, correlationId)
+	if err != nil || result == nil {
+		return 0, "", err
+	}
+	return result.Offset, result.Token, nil
+}
+
 func (c *BlobsCommandableHttpClientV1) BeginBlobRead(ctx context.Context, correlationId string, blobId string) (blob *BlobInfoV1, err error) {
 	params := data.NewAnyValueMapFromTuples(
 		"blob_id", blobId,
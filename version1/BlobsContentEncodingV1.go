@@ -0,0 +1,187 @@
+package version1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+const (
+	ContentEncodingNone = ""
+	ContentEncodingGzip = "gzip"
+	ContentEncodingZstd = "zstd"
+)
+
+// BlobsContentCodecV1 compresses blob content before upload and decompresses it on reads
+type BlobsContentCodecV1 struct {
+	NewWriter func(w io.Writer) (io.WriteCloser, error)
+	NewReader func(r io.Reader) (io.ReadCloser, error)
+}
+
+var blobsContentCodecs = map[string]*BlobsContentCodecV1{
+	ContentEncodingGzip: {
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+	},
+	ContentEncodingZstd: {
+		NewWriter: func(w io.Writer) (io.WriteCloser, error) {
+			return zstd.NewWriter(w)
+		},
+		NewReader: func(r io.Reader) (io.ReadCloser, error) {
+			// A single blob is decoded at a time, so background decoding goroutines are not needed
+			decoder, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				return nil, err
+			}
+			return decoder.IOReadCloser(), nil
+		},
+	},
+}
+var blobsContentCodecsLock sync.RWMutex
+
+// RegisterBlobsContentCodecV1 adds a codec for the content encoding or replaces the built-in one.
+// Gzip and zstd are available out of the box.
+func RegisterBlobsContentCodecV1(encoding string, codec *BlobsContentCodecV1) {
+	blobsContentCodecsLock.Lock()
+	defer blobsContentCodecsLock.Unlock()
+
+	blobsContentCodecs[strings.ToLower(encoding)] = codec
+}
+
+func getBlobsContentCodec(correlationId string, encoding string) (*BlobsContentCodecV1, error) {
+	blobsContentCodecsLock.RLock()
+	defer blobsContentCodecsLock.RUnlock()
+
+	codec, ok := blobsContentCodecs[strings.ToLower(encoding)]
+	if !ok {
+		return nil, cerr.NewBadRequestError(correlationId, "UNSUPPORTED_ENCODING",
+			"Content encoding "+encoding+" is not supported").WithDetails("encoding", encoding)
+	}
+	return codec, nil
+}
+
+// prepareBlobEncoding returns the codec to compress the new blob, or nil when content is sent as is.
+// Blobs that already declare their encoding carry compressed content and are not compressed again.
+func prepareBlobEncoding(correlationId string, blob *BlobInfoV1, options *BlobsTransferOptionsV1) (*BlobsContentCodecV1, error) {
+	encoding := options.getContentEncoding()
+	if encoding == ContentEncodingNone || blob.ContentEncoding != ContentEncodingNone {
+		return nil, nil
+	}
+
+	codec, err := getBlobsContentCodec(correlationId, encoding)
+	if err != nil {
+		return nil, err
+	}
+	blob.ContentEncoding = strings.ToLower(encoding)
+	return codec, nil
+}
+
+// getBlobDecoding returns the codec to decompress the blob, or nil when content is returned as stored
+func getBlobDecoding(correlationId string, blob *BlobInfoV1, options *BlobsTransferOptionsV1) (*BlobsContentCodecV1, error) {
+	if blob == nil || blob.ContentEncoding == ContentEncodingNone || options.isRawContent() {
+		return nil, nil
+	}
+	return getBlobsContentCodec(correlationId, blob.ContentEncoding)
+}
+
+func encodeBlobData(codec *BlobsContentCodecV1, data []byte) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	writer, err := codec.NewWriter(buffer)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = writer.Write(data); err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func decodeBlobData(codec *BlobsContentCodecV1, data []byte) ([]byte, error) {
+	reader, err := codec.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
+// encodeBlobStream compresses the stream on the fly.
+// The result must be closed to release the compressing goroutine.
+func encodeBlobStream(codec *BlobsContentCodecV1, stream io.Reader) io.ReadCloser {
+	reader, writer := io.Pipe()
+
+	go func() {
+		encoder, err := codec.NewWriter(writer)
+		if err == nil {
+			_, err = io.Copy(encoder, stream)
+			err1 := encoder.Close()
+			if err == nil {
+				err = err1
+			}
+		}
+		writer.CloseWithError(err)
+	}()
+
+	return reader
+}
+
+// blobsDecodingWriter decompresses content written to it into the stream
+type blobsDecodingWriter struct {
+	writer *io.PipeWriter
+	done   chan error
+	closed bool
+	err    error
+}
+
+func newBlobsDecodingWriter(codec *BlobsContentCodecV1, stream io.Writer) *blobsDecodingWriter {
+	reader, writer := io.Pipe()
+	c := &blobsDecodingWriter{
+		writer: writer,
+		done:   make(chan error, 1),
+	}
+
+	go func() {
+		decoder, err := codec.NewReader(reader)
+		if err == nil {
+			_, err = io.Copy(stream, decoder)
+			decoder.Close()
+		}
+		// Unblock the producer when decoding failed
+		reader.CloseWithError(err)
+		c.done <- err
+	}()
+
+	return c
+}
+
+func (c *blobsDecodingWriter) Write(p []byte) (int, error) {
+	return c.writer.Write(p)
+}
+
+// Close waits until all content is decompressed
+func (c *blobsDecodingWriter) Close() error {
+	return c.CloseWithError(nil)
+}
+
+// CloseWithError stops decompression when content was not read completely
+func (c *blobsDecodingWriter) CloseWithError(err error) error {
+	if !c.closed {
+		c.closed = true
+		c.writer.CloseWithError(err)
+		c.err = <-c.done
+	}
+	return c.err
+}
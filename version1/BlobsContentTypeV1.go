@@ -0,0 +1,117 @@
+package version1
+
+import (
+	"bufio"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// Number of bytes used by http.DetectContentType
+const blobsSniffSize = 512
+
+// detectBlobContentType sets content type of the blob when it is empty.
+// The type is taken from the extension of the blob name, otherwise it is sniffed from the head of the content.
+// Head may be nil when content is not available yet.
+func detectBlobContentType(blob *BlobInfoV1, head []byte) {
+	if blob.ContentType != "" {
+		return
+	}
+
+	if ext := filepath.Ext(blob.Name); ext != "" {
+		if contentType := mime.TypeByExtension(strings.ToLower(ext)); contentType != "" {
+			blob.ContentType = contentType
+			return
+		}
+	}
+
+	if head != nil {
+		blob.ContentType = http.DetectContentType(head)
+	}
+}
+
+// checkBlobContentType verifies content type of the blob against the allowlist from the options.
+// Allowed types may be exact, like "image/png", or contain wildcard subtype, like "image/*".
+func checkBlobContentType(correlationId string, blob *BlobInfoV1, options *BlobsTransferOptionsV1) error {
+	allowed := options.getAllowedContentTypes()
+	if len(allowed) == 0 {
+		return nil
+	}
+
+	contentType, _, err := mime.ParseMediaType(blob.ContentType)
+	if err != nil {
+		contentType = strings.ToLower(strings.TrimSpace(blob.ContentType))
+	}
+
+	for _, allowedType := range allowed {
+		allowedType = strings.ToLower(strings.TrimSpace(allowedType))
+		if allowedType == contentType || allowedType == "*/*" {
+			return nil
+		}
+		if strings.HasSuffix(allowedType, "/*") && contentType != "" &&
+			strings.HasPrefix(contentType, strings.TrimSuffix(allowedType, "*")) {
+			return nil
+		}
+	}
+
+	return cerr.NewBadRequestError(correlationId, "CONTENT_TYPE_NOT_ALLOWED",
+		"Content type "+blob.ContentType+" is not allowed for blob "+blob.Name).
+		WithDetails("blob_id", blob.Id).WithDetails("content_type", blob.ContentType)
+}
+
+// prepareBlobContentType detects content type of the blob from its content and verifies it
+func prepareBlobContentType(correlationId string, blob *BlobInfoV1, data []byte, options *BlobsTransferOptionsV1) error {
+	head := data
+	if len(head) > blobsSniffSize {
+		head = head[:blobsSniffSize]
+	}
+	detectBlobContentType(blob, head)
+	return checkBlobContentType(correlationId, blob, options)
+}
+
+// prepareBlobStreamContentType detects content type of the blob from the head of the stream and verifies it.
+// The returned stream must be used instead of the original one, as the head may be already consumed.
+func prepareBlobStreamContentType(correlationId string, blob *BlobInfoV1, stream io.Reader,
+	options *BlobsTransferOptionsV1) (io.Reader, error) {
+
+	detectBlobContentType(blob, nil)
+	if blob.ContentType == "" {
+		buffered := bufio.NewReaderSize(stream, blobsSniffSize)
+		head, err := buffered.Peek(blobsSniffSize)
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return nil, err
+		}
+		detectBlobContentType(blob, head)
+		stream = buffered
+	}
+
+	if err := checkBlobContentType(correlationId, blob, options); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+// prepareBlobSeekerContentType detects content type of the blob from the head of the stream,
+// verifies it and returns the stream back to its position
+func prepareBlobSeekerContentType(correlationId string, blob *BlobInfoV1, stream io.ReadSeeker,
+	options *BlobsTransferOptionsV1) error {
+
+	detectBlobContentType(blob, nil)
+	if blob.ContentType == "" {
+		head := make([]byte, blobsSniffSize)
+		size, err := io.ReadFull(stream, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		if _, err = stream.Seek(int64(-size), io.SeekCurrent); err != nil {
+			return err
+		}
+		detectBlobContentType(blob, head[:size])
+	}
+
+	return checkBlobContentType(correlationId, blob, options)
+}
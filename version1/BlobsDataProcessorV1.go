@@ -1,6 +1,12 @@
 package version1
 
-import "context"
+import (
+	"bytes"
+	"context"
+	"io"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
 
 type TBlobsDataProcessorV1 struct{}
 
@@ -8,6 +14,52 @@ var BlobsDataProcessorV1 = &TBlobsDataProcessorV1{}
 
 func (c *TBlobsDataProcessorV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	writer IBlobsChunkyWriterV1, data []byte, chunkSize int) (*BlobInfoV1, error) {
+	return c.CreateBlobFromDataWithOptions(ctx, correlationId, blob, writer, data,
+		NewBlobsTransferOptionsV1WithChunkSize(chunkSize))
+}
+
+func (c *TBlobsDataProcessorV1) CreateBlobFromDataWithOptions(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	writer IBlobsChunkyWriterV1, data []byte, options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
+	concurrency := options.getUploadConcurrency()
+
+	// Content type is checked before anything is sent
+	err := prepareBlobContentType(correlationId, blob, data, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compress the content before it is sent
+	codec, err := prepareBlobEncoding(correlationId, blob, options)
+	if err != nil {
+		return nil, err
+	}
+	if codec != nil {
+		if data, err = encodeBlobData(codec, data); err != nil {
+			return nil, err
+		}
+	}
+
+	// Whole content is known, so the service can verify the digest
+	checksum := options.newChecksum()
+	checksum.prepare(blob)
+	checksum.Write(data)
+	if checksum != nil {
+		blob.Checksum = checksum.sum()
+	}
+
+	progress := newBlobsProgress(ctx, correlationId, BlobsTransferUpload, blob.Id, int64(len(data)), options)
+
+	// Send chunks in parallel when the writer supports offset-tagged parts
+	partWriter, ok := writer.(IBlobsChunkyPartWriterV1)
+	if ok && concurrency > 1 && len(data) > chunkSize && partWriter.SupportsBlobParts(ctx, correlationId) {
+		blob, err := c.createBlobFromDataInParts(ctx, correlationId, blob, writer, partWriter, data, progress, options)
+		if err != nil {
+			return nil, err
+		}
+		return progress.complete(checksum.complete(ctx, correlationId, writer, blob))
+	}
 
 	buffer := data
 	skip := 0
@@ -15,24 +67,34 @@ func (c *TBlobsDataProcessorV1) CreateBlobFromData(ctx context.Context, correlat
 	token := ""
 
 	// Start writing when first chunk comes
-	token, err := writer.BeginBlobWrite(ctx, correlationId, blob)
+	token, err = writer.BeginBlobWrite(ctx, correlationId, blob)
 	if err != nil {
 		return nil, err
 	}
 
 	// Write chunks
-	for size > chunkSize {
-		take := chunkSize
-		if take > len(buffer)-skip {
-			take = len(buffer) - skip
+	sizer := newBlobsChunkSizer(options)
+	for {
+		// The rest goes with the end of the write
+		take := sizer.next(chunkSize)
+		if size <= take {
+			break
 		}
 		chunk := buffer[skip : skip+take]
 
-		token, err = writer.WriteBlobChunk(ctx, correlationId, token, chunk)
+		// Stop between chunks when the transfer is cancelled
+		err = ctx.Err()
+		if err == nil {
+			err = options.getUploadLimiter().Wait(ctx, len(chunk))
+		}
+		if err == nil {
+			token, err = options.getRetries().writeChunk(ctx, correlationId, writer, token, int64(skip), chunk, sizer)
+		}
 		if err != nil {
-			writer.AbortBlobWrite(ctx, correlationId, token)
-			return nil, err
+			abortBlobWrite(correlationId, writer, token)
+			return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, int64(skip), err)
 		}
+		progress.add(take)
 
 		skip = skip + take
 		size = size - take
@@ -41,45 +103,147 @@ func (c *TBlobsDataProcessorV1) CreateBlobFromData(ctx context.Context, correlat
 	// End writing
 	chunk := buffer[skip:]
 
-	blob, err = writer.EndBlobWrite(ctx, correlationId, token, chunk)
+	err = ctx.Err()
+	if err == nil {
+		err = options.getUploadLimiter().Wait(ctx, len(chunk))
+	}
+	var result *BlobInfoV1
+	if err == nil {
+		result, err = writer.EndBlobWrite(ctx, correlationId, token, chunk)
+	}
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, int64(skip), err)
+	}
+	progress.add(len(chunk))
+
+	return progress.complete(checksum.complete(ctx, correlationId, writer, result))
+}
+
+func (c *TBlobsDataProcessorV1) createBlobFromDataInParts(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	writer IBlobsChunkyWriterV1, partWriter IBlobsChunkyPartWriterV1, data []byte, progress *blobsProgress,
+	options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
+
+	token, err := writer.BeginBlobWrite(ctx, correlationId, blob)
 	if err != nil {
-		writer.AbortBlobWrite(ctx, correlationId, token)
 		return nil, err
 	}
 
-	return blob, nil
+	// Write parts
+	uploader := newBlobsPartUploader(ctx, correlationId, partWriter, token, progress, options)
+	for skip := 0; skip < len(data); skip += chunkSize {
+		take := chunkSize
+		if take > len(data)-skip {
+			take = len(data) - skip
+		}
+
+		if _, err = uploader.acquire(); err != nil {
+			break
+		}
+		uploader.upload(int64(skip), data[skip:skip+take], nil)
+	}
+
+	err1 := uploader.wait()
+	if err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, 0, err)
+	}
+
+	// End writing
+	result, err := writer.EndBlobWrite(ctx, correlationId, token, nil)
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, int64(len(data)), err)
+	}
+
+	return result, nil
 }
 
 func (c *TBlobsDataProcessorV1) GetBlobDataById(ctx context.Context, correlationId string, blobId string,
 	reader IBlobsChunkyReaderV1, chunkSize int) ([]byte, *BlobInfoV1, error) {
+	return c.GetBlobDataByIdWithOptions(ctx, correlationId, blobId, reader,
+		NewBlobsTransferOptionsV1WithChunkSize(chunkSize))
+}
+
+func (c *TBlobsDataProcessorV1) GetBlobDataByIdWithOptions(ctx context.Context, correlationId string, blobId string,
+	reader IBlobsChunkyReaderV1, options *BlobsTransferOptionsV1) ([]byte, *BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
 
 	// Read blob, start reading
 	blob, err := reader.BeginBlobRead(ctx, correlationId, blobId)
 	if err != nil {
 		return nil, nil, err
 	}
+	if blob == nil {
+		return nil, nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+	}
+
+	progress := newBlobsProgress(ctx, correlationId, BlobsTransferDownload, blobId, blob.Size, options)
 
-	// Read all chunks until the end
+	// Download chunks ahead of the consumer
+	readAhead := options.getReadAhead()
+	if readAhead > 1 && blob.Size > int64(chunkSize) {
+		buffer, err := c.readDataAhead(ctx, correlationId, blobId, blob.Size, reader, progress, options)
+		if err != nil {
+			return nil, nil, err
+		}
+		if int64(len(buffer)) < blob.Size {
+			return nil, nil, NewBlobsTruncatedErrorV1(correlationId, blobId, blob.Size, int64(len(buffer)))
+		}
+		return c.completeBlobData(correlationId, blob, buffer, progress, options)
+	}
+
+	// Read all chunks until the end.
+	// Short chunks are followed by requests for the rest, empty chunk means the content ended.
 	skip := int64(0)
 	size := blob.Size
 	buffer := []byte{}
+	sizer := newBlobsChunkSizer(options)
 
 	for size > 0 {
-		take := int64(chunkSize)
+		take := int64(sizer.next(chunkSize))
 		if take > size {
 			take = size
 		}
 
-		chunk, err1 := reader.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+		// Stop between chunks when the transfer is cancelled
+		err1 := ctx.Err()
+		if err1 == nil {
+			err1 = options.getDownloadLimiter().Wait(ctx, int(take))
+		}
+		var chunk []byte
+		if err1 == nil {
+			chunk, err1 = options.getRetries().readChunk(ctx, correlationId, reader, blobId, skip, take, sizer)
+		}
 		if err1 != nil {
-			return nil, nil, err1
+			endBlobRead(correlationId, reader, blobId)
+			return nil, nil, wrapBlobsContextError(ctx, correlationId, blobId, skip, err1)
 		}
 
-		if chunk != nil {
-			buffer = append(buffer, chunk...)
-			size = size - int64(len(chunk))
-			skip = skip + int64(len(chunk))
+		// Content shorter than the blob size must not pass for the whole blob
+		if len(chunk) == 0 {
+			endBlobRead(correlationId, reader, blobId)
+			return nil, nil, NewBlobsTruncatedErrorV1(correlationId, blobId, blob.Size, skip)
+		}
+		// Bytes beyond the requested range are read again with the following chunk
+		if int64(len(chunk)) > take {
+			chunk = chunk[:take]
 		}
+
+		buffer = append(buffer, chunk...)
+		size = size - int64(len(chunk))
+		skip = skip + int64(len(chunk))
+		progress.add(len(chunk))
 	}
 
 	// End reading
@@ -88,5 +252,77 @@ func (c *TBlobsDataProcessorV1) GetBlobDataById(ctx context.Context, correlation
 		return nil, nil, err
 	}
 
+	return c.completeBlobData(correlationId, blob, buffer, progress, options)
+}
+
+// completeBlobData verifies the content that was read and decompresses it
+func (c *TBlobsDataProcessorV1) completeBlobData(correlationId string, blob *BlobInfoV1, buffer []byte,
+	progress *blobsProgress, options *BlobsTransferOptionsV1) ([]byte, *BlobInfoV1, error) {
+
+	checksum := newBlobsChecksumForRead(blob)
+	checksum.Write(buffer)
+	if err := checksum.verify(correlationId, blob); err != nil {
+		return nil, nil, err
+	}
+
+	codec, err := getBlobDecoding(correlationId, blob, options)
+	if err != nil {
+		return nil, nil, err
+	}
+	if codec != nil {
+		if buffer, err = decodeBlobData(codec, buffer); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	progress.done()
 	return buffer, blob, nil
 }
+
+func (c *TBlobsDataProcessorV1) readDataAhead(ctx context.Context, correlationId string, blobId string, size int64,
+	reader IBlobsChunkyReaderV1, progress *blobsProgress, options *BlobsTransferOptionsV1) ([]byte, error) {
+
+	prefetcher := newBlobsChunkPrefetcher(ctx, correlationId, reader, blobId, size, options)
+	defer prefetcher.close()
+
+	buffer := make([]byte, 0, size)
+	for {
+		chunk, err := prefetcher.next()
+		if err == io.EOF {
+			break
+		}
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			endBlobRead(correlationId, reader, blobId)
+			return nil, wrapBlobsContextError(ctx, correlationId, blobId, int64(len(buffer)), err)
+		}
+		buffer = append(buffer, chunk...)
+		progress.add(len(chunk))
+	}
+
+	// End reading
+	err := reader.EndBlobRead(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer, nil
+}
+
+// GetBlobRangeByIdWithOptions reads length bytes of the blob starting from offset.
+// Negative length reads the blob till the end.
+func (c *TBlobsDataProcessorV1) GetBlobRangeByIdWithOptions(ctx context.Context, correlationId string,
+	blobId string, offset int64, length int64, reader IBlobsChunkyReaderV1,
+	options *BlobsTransferOptionsV1) ([]byte, *BlobInfoV1, error) {
+
+	buffer := &bytes.Buffer{}
+	blob, err := BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, correlationId,
+		blobId, offset, length, reader, buffer, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return buffer.Bytes(), blob, nil
+}
@@ -0,0 +1,611 @@
+package version1
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	crun "github.com/pip-services3-gox/pip-services3-commons-gox/run"
+)
+
+// BlobsEncryptingClientV1 encrypts blob content before it leaves the process
+// and decrypts it on all read paths, see BlobEncryptionSchemeV1.
+// Every blob gets its own data key that is wrapped by the key provider and kept
+// in the blob metadata together with the nonce and the segment size.
+// Blobs are returned with the size of decrypted content and without encryption metadata.
+// Blobs stored without encryption are read as is.
+//
+//	Configuration parameters:
+//		- encryption:
+//			- key_file: master key file of the default key provider
+//		- options:
+//			- chunk_size: size of encrypted segments (default: 10240)
+//			- content_encoding: is not supported, encrypted segments are located by the stored size
+//		- other parameters are passed to the decorated client
+type BlobsEncryptingClientV1 struct {
+	client  IBlobsClientV1
+	keys    IBlobsKeyProviderV1
+	options *BlobsTransferOptionsV1
+	lock    sync.Mutex
+	reads   map[string]*blobsEncryptedRead
+}
+
+// blobsEncryptedRead is a blob opened by BeginBlobRead
+type blobsEncryptedRead struct {
+	blob   *BlobInfoV1
+	cipher *blobsCipher
+}
+
+// NewBlobsEncryptingClientV1 decorates the client. Without key provider
+// the master key is taken from the file set by encryption.key_file parameter.
+func NewBlobsEncryptingClientV1(client IBlobsClientV1, keys IBlobsKeyProviderV1) *BlobsEncryptingClientV1 {
+	if keys == nil {
+		keys = NewBlobsFileKeyProviderV1("")
+	}
+
+	return &BlobsEncryptingClientV1{
+		client:  client,
+		keys:    keys,
+		options: NewBlobsTransferOptionsV1(),
+		reads:   make(map[string]*blobsEncryptedRead),
+	}
+}
+
+func (c *BlobsEncryptingClientV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.options.Configure(ctx, config)
+
+	if configurable, ok := c.keys.(cconf.IConfigurable); ok {
+		configurable.Configure(ctx, config)
+	}
+	if configurable, ok := c.client.(cconf.IConfigurable); ok {
+		configurable.Configure(ctx, config)
+	}
+}
+
+func (c *BlobsEncryptingClientV1) IsOpen() bool {
+	if openable, ok := c.client.(crun.IOpenable); ok {
+		return openable.IsOpen()
+	}
+	return true
+}
+
+func (c *BlobsEncryptingClientV1) Open(ctx context.Context, correlationId string) error {
+	if err := c.checkEncoding(correlationId); err != nil {
+		return err
+	}
+	if openable, ok := c.client.(crun.IOpenable); ok {
+		return openable.Open(ctx, correlationId)
+	}
+	return nil
+}
+
+func (c *BlobsEncryptingClientV1) Close(ctx context.Context, correlationId string) error {
+	if openable, ok := c.client.(crun.IOpenable); ok {
+		return openable.Close(ctx, correlationId)
+	}
+	return nil
+}
+
+// Client returns the decorated client
+func (c *BlobsEncryptingClientV1) Client() IBlobsClientV1 {
+	return c.client
+}
+
+// checkEncoding rejects content encoding of the decorated client,
+// as the stored size of compressed content does not locate encrypted segments
+func (c *BlobsEncryptingClientV1) checkEncoding(correlationId string) error {
+	if encoding := c.options.getContentEncoding(); encoding != ContentEncodingNone {
+		return cerr.NewConfigError(correlationId, "UNSUPPORTED_ENCODING",
+			"Content encoding "+encoding+" is not supported by encrypted blobs").WithDetails("encoding", encoding)
+	}
+	return nil
+}
+
+// newCipher generates the data key for a new blob. Encryption parameters are kept
+// in the metadata of the returned blob copy, which is passed to the decorated client.
+func (c *BlobsEncryptingClientV1) newCipher(ctx context.Context, correlationId string,
+	blob *BlobInfoV1) (*blobsCipher, *BlobInfoV1, error) {
+	if err := c.checkEncoding(correlationId); err != nil {
+		return nil, nil, err
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, 12)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, err
+	}
+	segmentSize := int64(c.options.getChunkSize())
+
+	cipher, err := newBlobsCipher(key, nonce, segmentSize)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyId, wrappedKey, err := c.keys.WrapKey(ctx, correlationId, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The blob of the caller is not changed
+	encrypted := *blob
+	metadata := make(map[string]string, len(blob.Metadata)+5)
+	for k, v := range blob.Metadata {
+		metadata[k] = v
+	}
+	metadata[blobMetaEncryptionScheme] = BlobEncryptionSchemeV1
+	metadata[blobMetaEncryptionKeyId] = keyId
+	metadata[blobMetaEncryptionKey] = base64.StdEncoding.EncodeToString(wrappedKey)
+	metadata[blobMetaEncryptionNonce] = base64.StdEncoding.EncodeToString(nonce)
+	metadata[blobMetaEncryptionSegmentSize] = strconv.FormatInt(segmentSize, 10)
+	encrypted.Metadata = metadata
+
+	return cipher, &encrypted, nil
+}
+
+// getCipher restores the cipher from the blob metadata, or returns nil for blobs stored without encryption
+func (c *BlobsEncryptingClientV1) getCipher(ctx context.Context, correlationId string, blob *BlobInfoV1) (*blobsCipher, error) {
+	if blob == nil || blob.Metadata[blobMetaEncryptionScheme] == "" {
+		return nil, nil
+	}
+
+	scheme := blob.Metadata[blobMetaEncryptionScheme]
+	if scheme != BlobEncryptionSchemeV1 {
+		return nil, cerr.NewBadRequestError(correlationId, "UNSUPPORTED_ENCRYPTION",
+			"Blob "+blob.Id+" is encrypted with unsupported scheme "+scheme).
+			WithDetails("blob_id", blob.Id).WithDetails("scheme", scheme)
+	}
+	if blob.ContentEncoding != ContentEncodingNone {
+		return nil, cerr.NewBadRequestError(correlationId, "UNSUPPORTED_ENCODING",
+			"Encrypted blob "+blob.Id+" is stored with content encoding "+blob.ContentEncoding).
+			WithDetails("blob_id", blob.Id).WithDetails("encoding", blob.ContentEncoding)
+	}
+
+	wrappedKey, err1 := base64.StdEncoding.DecodeString(blob.Metadata[blobMetaEncryptionKey])
+	nonce, err2 := base64.StdEncoding.DecodeString(blob.Metadata[blobMetaEncryptionNonce])
+	segmentSize, err3 := strconv.ParseInt(blob.Metadata[blobMetaEncryptionSegmentSize], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return nil, cerr.NewBadRequestError(correlationId, "INVALID_ENCRYPTION",
+			"Encryption parameters of blob "+blob.Id+" are invalid").WithDetails("blob_id", blob.Id)
+	}
+
+	key, err := c.keys.UnwrapKey(ctx, correlationId, blob.Metadata[blobMetaEncryptionKeyId], wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlobsCipher(key, nonce, segmentSize)
+}
+
+// toPlainBlob hides encryption details from the callers
+func (c *BlobsEncryptingClientV1) toPlainBlob(blob *BlobInfoV1) *BlobInfoV1 {
+	if blob == nil || blob.Metadata[blobMetaEncryptionScheme] == "" {
+		return blob
+	}
+
+	result := *blob
+	result.Metadata = make(map[string]string)
+	for k, v := range blob.Metadata {
+		if !strings.HasPrefix(k, "encryption.") {
+			result.Metadata[k] = v
+		}
+	}
+	if len(result.Metadata) == 0 {
+		result.Metadata = nil
+	}
+
+	segmentSize, err := strconv.ParseInt(blob.Metadata[blobMetaEncryptionSegmentSize], 10, 64)
+	if err == nil && segmentSize > 0 {
+		result.Size = getEncryptedBlobPlainSize(blob.Size, segmentSize)
+	}
+
+	return &result
+}
+
+func (c *BlobsEncryptingClientV1) toPlainBlobs(blobs []*BlobInfoV1) []*BlobInfoV1 {
+	for i, blob := range blobs {
+		blobs[i] = c.toPlainBlob(blob)
+	}
+	return blobs
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
+	return c.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, nil)
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
+	if sorted, ok := c.client.(IBlobsSortedReaderV1); ok {
+		result, err = sorted.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, sort)
+	} else if sort == nil || len(*sort) == 0 {
+		result, err = c.client.GetBlobsByFilter(ctx, correlationId, filter, paging)
+	} else {
+		err = cerr.NewUnsupportedError(correlationId, "SORT_NOT_SUPPORTED",
+			"Wrapped blobs client does not sort blobs")
+	}
+	if err != nil {
+		return result, err
+	}
+	result.Data = c.toPlainBlobs(result.Data)
+	return result, nil
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobsByIds(ctx context.Context, correlationId string, blobIds []string) (result []*BlobInfoV1, err error) {
+	result, err = c.client.GetBlobsByIds(ctx, correlationId, blobIds)
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlobs(result), nil
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobById(ctx context.Context, correlationId string, blobId string) (result *BlobInfoV1, err error) {
+	result, err = c.client.GetBlobById(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlob(result), nil
+}
+
+func (c *BlobsEncryptingClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	uri string) (result *BlobInfoV1, err error) {
+	// Content is downloaded here, so the service never sees it unencrypted
+	stream, err := BlobsUriProcessorV1.openUri(ctx, correlationId, blob, uri, c.options)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	return c.CreateBlobFromStream(ctx, correlationId, blob, stream)
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
+	return "", cerr.NewUnsupportedError(correlationId, "NOT_SUPPORTED",
+		"Encrypted blobs can not be downloaded by uri").WithDetails("blob_id", blobId)
+}
+
+func (c *BlobsEncryptingClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	buffer []byte) (result *BlobInfoV1, err error) {
+	// Encrypted content can not be sniffed by the decorated client
+	err = prepareBlobContentType(correlationId, blob, buffer, c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	cipher, encrypted, err := c.newCipher(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	buffer, err = io.ReadAll(newBlobsEncryptingReader(cipher, bytes.NewReader(buffer)))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = c.client.CreateBlobFromData(ctx, correlationId, encrypted, buffer)
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlob(result), nil
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobDataById(ctx context.Context, correlationId string,
+	blobId string) (result []byte, blob *BlobInfoV1, err error) {
+	result, blob, err = c.client.GetBlobDataById(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cipher, err := c.getCipher(ctx, correlationId, blob)
+	if err != nil || cipher == nil {
+		return result, blob, err
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := newBlobsDecryptingWriter(correlationId, blobId, cipher, buffer)
+	if _, err = writer.Write(result); err != nil {
+		return nil, nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return buffer.Bytes(), c.toPlainBlob(blob), nil
+}
+
+func (c *BlobsEncryptingClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	stream io.Reader) (result *BlobInfoV1, err error) {
+	// Encrypted content can not be sniffed by the decorated client
+	stream, err = prepareBlobStreamContentType(correlationId, blob, stream, c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	cipher, encrypted, err := c.newCipher(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err = c.client.CreateBlobFromStream(ctx, correlationId, encrypted, newBlobsEncryptingReader(cipher, stream))
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlob(result), nil
+}
+
+func (c *BlobsEncryptingClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string,
+	stream io.Writer) (blob *BlobInfoV1, err error) {
+	// Keys are needed before the content comes
+	blob, err = c.client.GetBlobById(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := c.getCipher(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+	if cipher == nil {
+		return c.client.ReadBlobStreamById(ctx, correlationId, blobId, stream)
+	}
+
+	writer := newBlobsDecryptingWriter(correlationId, blobId, cipher, stream)
+	blob, err = c.client.ReadBlobStreamById(ctx, correlationId, blobId, writer)
+	if err != nil {
+		return nil, err
+	}
+	if err = writer.Close(); err != nil {
+		return nil, err
+	}
+
+	return c.toPlainBlob(blob), nil
+}
+
+func (c *BlobsEncryptingClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	buffer := &bytes.Buffer{}
+	blob, err = c.ReadBlobRangeStreamById(ctx, correlationId, blobId, offset, length, buffer)
+	if err != nil {
+		return nil, nil, err
+	}
+	return buffer.Bytes(), blob, nil
+}
+
+func (c *BlobsEncryptingClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	blob, err = c.client.GetBlobById(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+	}
+
+	cipher, err := c.getCipher(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+	if cipher == nil {
+		reader, err := c.getRangeReader(correlationId)
+		if err != nil {
+			return nil, err
+		}
+		return reader.ReadBlobRangeStreamById(ctx, correlationId, blobId, offset, length, stream)
+	}
+
+	err = c.readRange(ctx, correlationId, blob, cipher, offset, length, stream)
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlob(blob), nil
+}
+
+// getRangeReader returns the wrapped client when it can read ranges of stored content
+func (c *BlobsEncryptingClientV1) getRangeReader(correlationId string) (IBlobsRangeReaderV1, error) {
+	reader, ok := c.client.(IBlobsRangeReaderV1)
+	if !ok {
+		return nil, cerr.NewUnsupportedError(correlationId, "RANGE_NOT_SUPPORTED",
+			"Wrapped blobs client does not read ranges of blob content")
+	}
+	return reader, nil
+}
+
+// readRange decrypts only the segments that cover the requested range
+func (c *BlobsEncryptingClientV1) readRange(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	cipher *blobsCipher, offset int64, length int64, stream io.Writer) error {
+
+	reader, err := c.getRangeReader(correlationId)
+	if err != nil {
+		return err
+	}
+
+	size := cipher.plainSize(blob.Size)
+	if offset < 0 || offset > size {
+		return cerr.NewBadRequestError(correlationId, "INVALID_RANGE",
+			"Range offset "+strconv.FormatInt(offset, 10)+" is outside of blob "+blob.Id).
+			WithDetails("blob_id", blob.Id).WithDetails("offset", offset).WithDetails("size", size)
+	}
+	if length < 0 || offset+length > size {
+		length = size - offset
+	}
+	if length == 0 {
+		return nil
+	}
+
+	// Segments are read in batches to limit memory use
+	count := cipher.segmentCount(blob.Size)
+	batch := int64(c.options.getReadAhead())
+	end := offset + length
+	for index := offset / cipher.segmentSize; index*cipher.segmentSize < end; index += batch {
+		last := index + batch
+		if last > count {
+			last = count
+		}
+
+		sealed, _, err := reader.GetBlobRangeById(ctx, correlationId, blob.Id,
+			index*cipher.sealedSize(), (last-index)*cipher.sealedSize())
+		if err != nil {
+			return err
+		}
+
+		for i := index; i < last && i*cipher.segmentSize < end; i++ {
+			start := (i - index) * cipher.sealedSize()
+			if start >= int64(len(sealed)) {
+				return cerr.NewConflictError(correlationId, BlobDecryptionFailed,
+					"Blob "+blob.Id+" is shorter than expected").WithDetails("blob_id", blob.Id)
+			}
+			stop := start + cipher.sealedSize()
+			if stop > int64(len(sealed)) {
+				stop = int64(len(sealed))
+			}
+
+			segment, err := cipher.open(correlationId, blob.Id, i, i == count-1, sealed[start:stop])
+			if err != nil {
+				return err
+			}
+
+			// Cut the segment to the range
+			from := offset - i*cipher.segmentSize
+			if from < 0 {
+				from = 0
+			}
+			to := end - i*cipher.segmentSize
+			if to > int64(len(segment)) {
+				to = int64(len(segment))
+			}
+			if _, err = stream.Write(segment[from:to]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *BlobsEncryptingClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
+	current, err := c.client.GetBlobById(ctx, correlationId, blob.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep encryption parameters and stored size
+	if current != nil && current.Metadata[blobMetaEncryptionScheme] != "" {
+		update := *blob
+		update.Size = current.Size
+		update.Metadata = make(map[string]string)
+		for k, v := range blob.Metadata {
+			update.Metadata[k] = v
+		}
+		for k, v := range current.Metadata {
+			if strings.HasPrefix(k, "encryption.") {
+				update.Metadata[k] = v
+			}
+		}
+		blob = &update
+	}
+
+	result, err = c.client.UpdateBlobInfo(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+	return c.toPlainBlob(result), nil
+}
+
+func (c *BlobsEncryptingClientV1) MarkBlobsCompleted(ctx context.Context, correlationId string, blobIds []string) error {
+	return c.client.MarkBlobsCompleted(ctx, correlationId, blobIds)
+}
+
+func (c *BlobsEncryptingClientV1) DeleteBlobById(ctx context.Context, correlationId string, blobId string) error {
+	return c.client.DeleteBlobById(ctx, correlationId, blobId)
+}
+
+func (c *BlobsEncryptingClientV1) DeleteBlobsByIds(ctx context.Context, correlationId string, blobIds []string) error {
+	return c.client.DeleteBlobsByIds(ctx, correlationId, blobIds)
+}
+
+func (c *BlobsEncryptingClientV1) BeginBlobRead(ctx context.Context, correlationId string, blobId string) (*BlobInfoV1, error) {
+	blob, err := c.client.GetBlobById(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+	}
+
+	cipher, err := c.getCipher(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.reads[blobId] = &blobsEncryptedRead{blob: blob, cipher: cipher}
+	c.lock.Unlock()
+
+	return c.toPlainBlob(blob), nil
+}
+
+func (c *BlobsEncryptingClientV1) ReadBlobChunk(ctx context.Context, correlationId string, blobId string,
+	skip int64, take int64) ([]byte, error) {
+	c.lock.Lock()
+	read, ok := c.reads[blobId]
+	c.lock.Unlock()
+
+	// The blob may be read by several readers and one of them already ended
+	if !ok {
+		blob, err := c.client.GetBlobById(ctx, correlationId, blobId)
+		if err != nil {
+			return nil, err
+		}
+		if blob == nil {
+			return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+				"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+		}
+		cipher, err := c.getCipher(ctx, correlationId, blob)
+		if err != nil {
+			return nil, err
+		}
+		read = &blobsEncryptedRead{blob: blob, cipher: cipher}
+	}
+
+	if read.cipher == nil {
+		reader, err := c.getRangeReader(correlationId)
+		if err != nil {
+			return nil, err
+		}
+		result, _, err := reader.GetBlobRangeById(ctx, correlationId, blobId, skip, take)
+		return result, err
+	}
+
+	buffer := &bytes.Buffer{}
+	err := c.readRange(ctx, correlationId, read.blob, read.cipher, skip, take, buffer)
+	if err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+func (c *BlobsEncryptingClientV1) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
+	c.lock.Lock()
+	delete(c.reads, blobId)
+	c.lock.Unlock()
+	return nil
+}
+
+func (c *BlobsEncryptingClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobsEncryptingClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, c.options)
+}
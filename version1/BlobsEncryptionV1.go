@@ -0,0 +1,231 @@
+package version1
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"io"
+	"strconv"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobEncryptionSchemeV1 is the scheme of blobs encrypted by BlobsEncryptingClientV1.
+// Content is split into segments of a fixed size, every segment is sealed with AES-256-GCM
+// under the blob data key. Segment nonce is the blob nonce combined with the segment index,
+// and the index together with the last segment flag is authenticated, so segments
+// can not be reordered, dropped or cut off without detection.
+const BlobEncryptionSchemeV1 = "aes-256-gcm-segments"
+
+const BlobDecryptionFailed = "BLOB_DECRYPTION_FAILED"
+
+// Encryption parameters are kept in blob metadata
+const (
+	blobMetaEncryptionScheme      = "encryption.scheme"
+	blobMetaEncryptionKeyId       = "encryption.key_id"
+	blobMetaEncryptionKey         = "encryption.key"
+	blobMetaEncryptionNonce       = "encryption.nonce"
+	blobMetaEncryptionSegmentSize = "encryption.segment_size"
+)
+
+// Size of GCM authentication tag added to every segment
+const blobsTagSize = 16
+
+// blobsCipher seals and opens segments of a single blob
+type blobsCipher struct {
+	aead        cipher.AEAD
+	nonce       []byte
+	segmentSize int64
+}
+
+func newBlobsCipher(key []byte, nonce []byte, segmentSize int64) (*blobsCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() || segmentSize <= 0 {
+		return nil, cerr.NewBadRequestError("", "INVALID_ENCRYPTION", "Blob encryption parameters are invalid")
+	}
+
+	return &blobsCipher{
+		aead:        aead,
+		nonce:       nonce,
+		segmentSize: segmentSize,
+	}, nil
+}
+
+// sealedSize is the size of a full segment with its authentication tag
+func (c *blobsCipher) sealedSize() int64 {
+	return c.segmentSize + blobsTagSize
+}
+
+// segmentCount returns number of segments in the stored content
+func (c *blobsCipher) segmentCount(storedSize int64) int64 {
+	return (storedSize + c.sealedSize() - 1) / c.sealedSize()
+}
+
+// plainSize returns size of the content before encryption
+func (c *blobsCipher) plainSize(storedSize int64) int64 {
+	return getEncryptedBlobPlainSize(storedSize, c.segmentSize)
+}
+
+// getEncryptedBlobPlainSize calculates size of the content before encryption from the stored size
+func getEncryptedBlobPlainSize(storedSize int64, segmentSize int64) int64 {
+	sealedSize := segmentSize + blobsTagSize
+	size := storedSize - (storedSize+sealedSize-1)/sealedSize*blobsTagSize
+	if size < 0 {
+		return 0
+	}
+	return size
+}
+
+func (c *blobsCipher) segmentParams(index int64, last bool) ([]byte, []byte) {
+	nonce := make([]byte, len(c.nonce))
+	copy(nonce, c.nonce)
+	position := len(nonce) - 8
+	binary.BigEndian.PutUint64(nonce[position:], binary.BigEndian.Uint64(nonce[position:])^uint64(index))
+
+	data := make([]byte, 9)
+	binary.BigEndian.PutUint64(data, uint64(index))
+	if last {
+		data[8] = 1
+	}
+	return nonce, data
+}
+
+func (c *blobsCipher) seal(index int64, last bool, segment []byte) []byte {
+	nonce, data := c.segmentParams(index, last)
+	return c.aead.Seal(nil, nonce, segment, data)
+}
+
+func (c *blobsCipher) open(correlationId string, blobId string, index int64, last bool, segment []byte) ([]byte, error) {
+	nonce, data := c.segmentParams(index, last)
+	result, err := c.aead.Open(nil, nonce, segment, data)
+	if err != nil {
+		return nil, cerr.NewConflictError(correlationId, BlobDecryptionFailed,
+			"Failed to decrypt segment "+strconv.FormatInt(index, 10)+" of blob "+blobId).
+			WithDetails("blob_id", blobId).WithDetails("segment", index)
+	}
+	return result, nil
+}
+
+// blobsEncryptingReader encrypts content of the source as it is read.
+// One segment is read ahead to know which segment is the last.
+type blobsEncryptingReader struct {
+	source  io.Reader
+	cipher  *blobsCipher
+	index   int64
+	current []byte
+	output  []byte
+	started bool
+	done    bool
+}
+
+func newBlobsEncryptingReader(cipher *blobsCipher, source io.Reader) *blobsEncryptingReader {
+	return &blobsEncryptingReader{
+		source: source,
+		cipher: cipher,
+	}
+}
+
+func (c *blobsEncryptingReader) Read(p []byte) (int, error) {
+	for len(c.output) == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.sealNext(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, c.output)
+	c.output = c.output[n:]
+	return n, nil
+}
+
+func (c *blobsEncryptingReader) readSegment() ([]byte, error) {
+	buffer := make([]byte, c.cipher.segmentSize)
+	n, err := io.ReadFull(c.source, buffer)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return buffer[:n], err
+}
+
+func (c *blobsEncryptingReader) sealNext() error {
+	if !c.started {
+		c.started = true
+		segment, err := c.readSegment()
+		if err != nil {
+			return err
+		}
+		c.current = segment
+	}
+
+	next, err := c.readSegment()
+	if err != nil {
+		return err
+	}
+
+	last := len(next) == 0
+	c.output = c.cipher.seal(c.index, last, c.current)
+	c.index++
+	c.current = next
+	c.done = last
+	return nil
+}
+
+// blobsDecryptingWriter decrypts sealed segments written to it into the stream.
+// The last segment is opened in Close.
+type blobsDecryptingWriter struct {
+	stream        io.Writer
+	cipher        *blobsCipher
+	correlationId string
+	blobId        string
+	index         int64
+	buffer        []byte
+}
+
+func newBlobsDecryptingWriter(correlationId string, blobId string, cipher *blobsCipher,
+	stream io.Writer) *blobsDecryptingWriter {
+	return &blobsDecryptingWriter{
+		stream:        stream,
+		cipher:        cipher,
+		correlationId: correlationId,
+		blobId:        blobId,
+	}
+}
+
+func (c *blobsDecryptingWriter) Write(p []byte) (int, error) {
+	c.buffer = append(c.buffer, p...)
+
+	// A full segment is the last one until more content comes
+	sealedSize := int(c.cipher.sealedSize())
+	for len(c.buffer) > sealedSize {
+		segment, err := c.cipher.open(c.correlationId, c.blobId, c.index, false, c.buffer[:sealedSize])
+		if err != nil {
+			return 0, err
+		}
+		if _, err = c.stream.Write(segment); err != nil {
+			return 0, err
+		}
+		c.index++
+		c.buffer = c.buffer[sealedSize:]
+	}
+
+	return len(p), nil
+}
+
+func (c *blobsDecryptingWriter) Close() error {
+	segment, err := c.cipher.open(c.correlationId, c.blobId, c.index, true, c.buffer)
+	if err != nil {
+		return err
+	}
+	c.buffer = nil
+	_, err = c.stream.Write(segment)
+	return err
+}
@@ -0,0 +1,111 @@
+package version1
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// Time given to release a transfer on the server after its context was cancelled
+const blobsCleanupTimeout = 5 * time.Second
+
+const (
+	BlobTransferCancelled = "BLOB_TRANSFER_CANCELLED"
+	BlobTruncated         = "BLOB_TRUNCATED"
+)
+
+// BlobsTransferCancelledErrorV1 is returned when the context of a transfer
+// is cancelled or its deadline is exceeded. It matches the context error,
+// so errors.Is(err, context.Canceled) and errors.Is(err, context.DeadlineExceeded) hold.
+type BlobsTransferCancelledErrorV1 struct {
+	*cerr.ApplicationError
+	BlobId string
+	Offset int64
+	Err    error
+}
+
+func NewBlobsTransferCancelledErrorV1(correlationId string, blobId string, offset int64,
+	ctxErr error) *BlobsTransferCancelledErrorV1 {
+
+	err := cerr.NewInvocationError(correlationId, BlobTransferCancelled,
+		"Transfer of blob "+blobId+" stopped at offset "+strconv.FormatInt(offset, 10)+": "+ctxErr.Error()).
+		WithDetails("blob_id", blobId).
+		WithDetails("offset", offset).
+		WithCause(ctxErr)
+
+	return &BlobsTransferCancelledErrorV1{
+		ApplicationError: err,
+		BlobId:           blobId,
+		Offset:           offset,
+		Err:              ctxErr,
+	}
+}
+
+func (e *BlobsTransferCancelledErrorV1) Unwrap() error {
+	return e.ApplicationError
+}
+
+func (e *BlobsTransferCancelledErrorV1) Is(target error) bool {
+	return target == e.Err
+}
+
+// BlobsTruncatedErrorV1 is returned when the content ends before
+// the size of the blob was read. It matches io.ErrUnexpectedEOF.
+type BlobsTruncatedErrorV1 struct {
+	*cerr.ApplicationError
+	BlobId   string
+	Expected int64
+	Actual   int64
+}
+
+func NewBlobsTruncatedErrorV1(correlationId string, blobId string, expected int64,
+	actual int64) *BlobsTruncatedErrorV1 {
+
+	err := cerr.NewConflictError(correlationId, BlobTruncated,
+		"Blob "+blobId+" ended after "+strconv.FormatInt(actual, 10)+
+			" of "+strconv.FormatInt(expected, 10)+" bytes").
+		WithDetails("blob_id", blobId).
+		WithDetails("expected", expected).
+		WithDetails("actual", actual)
+
+	return &BlobsTruncatedErrorV1{
+		ApplicationError: err,
+		BlobId:           blobId,
+		Expected:         expected,
+		Actual:           actual,
+	}
+}
+
+func (e *BlobsTruncatedErrorV1) Unwrap() error {
+	return e.ApplicationError
+}
+
+func (e *BlobsTruncatedErrorV1) Is(target error) bool {
+	return target == io.ErrUnexpectedEOF
+}
+
+// wrapBlobsContextError replaces the error with BlobsTransferCancelledErrorV1 when the context is done,
+// as errors of chunk operations interrupted by cancellation say little about the reason
+func wrapBlobsContextError(ctx context.Context, correlationId string, blobId string, offset int64, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return NewBlobsTransferCancelledErrorV1(correlationId, blobId, offset, ctxErr)
+	}
+	return err
+}
+
+// abortBlobWrite cancels the write on the server even when the transfer context is already done
+func abortBlobWrite(correlationId string, writer IBlobsChunkyWriterV1, token string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), blobsCleanupTimeout)
+	defer cancel()
+	return writer.AbortBlobWrite(ctx, correlationId, token)
+}
+
+// endBlobRead releases the read on the server even when the transfer context is already done
+func endBlobRead(correlationId string, reader IBlobsChunkyReaderV1, blobId string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), blobsCleanupTimeout)
+	defer cancel()
+	return reader.EndBlobRead(ctx, correlationId, blobId)
+}
@@ -0,0 +1,71 @@
+package version1
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Optional operations a server advertises through get_features
+const (
+	BlobsFeatureUploadBlob    = "upload_blob"
+	BlobsFeatureDownloadBlob  = "download_blob"
+	BlobsFeatureWriteBlobPart = "write_blob_part"
+)
+
+// blobsFeatures caches the features advertised by the server.
+// Servers that do not implement get_features support none.
+type blobsFeatures struct {
+	lock     sync.Mutex
+	features map[string]bool
+}
+
+// supports checks the feature, the server is probed on the first call.
+// The probe is not made under the lock, so a slow server does not block other calls.
+func (c *blobsFeatures) supports(ctx context.Context, correlationId string, feature string,
+	probe func(ctx context.Context, correlationId string) ([]string, error)) bool {
+
+	c.lock.Lock()
+	features := c.features
+	c.lock.Unlock()
+
+	if features == nil {
+		list, err := probe(ctx, correlationId)
+		if err != nil && !isBlobsUnimplementedError(err) {
+			// Try again on the next call
+			return false
+		}
+
+		features = make(map[string]bool)
+		for _, f := range list {
+			features[f] = true
+		}
+
+		c.lock.Lock()
+		c.features = features
+		c.lock.Unlock()
+	}
+
+	return features[feature]
+}
+
+// reset makes the next call probe the server again
+func (c *blobsFeatures) reset() {
+	c.lock.Lock()
+	c.features = nil
+	c.lock.Unlock()
+}
+
+// isBlobsUnimplementedError checks if the server does not know the called operation
+func isBlobsUnimplementedError(err error) bool {
+	if status.Code(err) == codes.Unimplemented {
+		return true
+	}
+
+	var appErr *cerr.ApplicationError
+	return errors.As(err, &appErr) && (appErr.Category == cerr.NotFound || appErr.Category == cerr.Unsupported)
+}
@@ -0,0 +1,90 @@
+package version1
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobsFileCheckpointStoreV1 keeps every checkpoint in a JSON file inside a directory,
+// so uploads can be resumed after the process restarts.
+type BlobsFileCheckpointStoreV1 struct {
+	path string
+}
+
+func NewBlobsFileCheckpointStoreV1(path string) *BlobsFileCheckpointStoreV1 {
+	return &BlobsFileCheckpointStoreV1{
+		path: path,
+	}
+}
+
+func (c *BlobsFileCheckpointStoreV1) getFileName(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(c.path, hex.EncodeToString(hash[:])+".json")
+}
+
+func (c *BlobsFileCheckpointStoreV1) LoadCheckpoint(ctx context.Context, correlationId string, key string) (checkpoint *BlobsUploadCheckpointV1, err error) {
+	fileName := c.getFileName(key)
+
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read checkpoint file "+fileName).WithCause(err)
+	}
+
+	checkpoint = &BlobsUploadCheckpointV1{}
+	err = json.Unmarshal(buffer, checkpoint)
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to parse checkpoint file "+fileName).WithCause(err)
+	}
+
+	return checkpoint, nil
+}
+
+func (c *BlobsFileCheckpointStoreV1) SaveCheckpoint(ctx context.Context, correlationId string, checkpoint *BlobsUploadCheckpointV1) error {
+	fileName := c.getFileName(checkpoint.Key)
+
+	buffer, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(c.path, 0755)
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to create checkpoint folder "+c.path).WithCause(err)
+	}
+
+	// Write to a temporary file first, so a crash never leaves a partial checkpoint
+	err = os.WriteFile(fileName+".tmp", buffer, 0644)
+	if err == nil {
+		err = os.Rename(fileName+".tmp", fileName)
+	}
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to write checkpoint file "+fileName).WithCause(err)
+	}
+
+	return nil
+}
+
+func (c *BlobsFileCheckpointStoreV1) DeleteCheckpoint(ctx context.Context, correlationId string, key string) error {
+	fileName := c.getFileName(key)
+
+	err := os.Remove(fileName)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.NewFileError(correlationId, "DELETE_FAILED",
+			"Failed to delete checkpoint file "+fileName).WithCause(err)
+	}
+
+	return nil
+}
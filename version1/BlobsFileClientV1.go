@@ -0,0 +1,682 @@
+package version1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/convert"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobsFileClientV1 keeps blobs in a local directory: content in <id>.dat files
+// and blob information in <id>.json sidecar files, so data survives restarts.
+// Unfinished writes are kept in the uploads subdirectory until they are completed.
+//
+//	Configuration parameters:
+//		- path: directory to store blobs (default: ./blobs)
+//		- options:
+//			- max_blob_size: maximum blob size in bytes (default: 100MB)
+//			- other transfer options, see BlobsTransferOptionsV1
+type BlobsFileClientV1 struct {
+	path        string
+	options     *BlobsTransferOptionsV1
+	maxBlobSize int64
+	lock        sync.Mutex
+}
+
+func NewBlobsFileClientV1() *BlobsFileClientV1 {
+	return &BlobsFileClientV1{
+		path:        "./blobs",
+		options:     NewBlobsTransferOptionsV1(),
+		maxBlobSize: 100 * 1024 * 1024,
+	}
+}
+
+func NewBlobsFileClientV1WithPath(path string) *BlobsFileClientV1 {
+	c := NewBlobsFileClientV1()
+	c.path = path
+	return c
+}
+
+func (c *BlobsFileClientV1) Configure(ctx context.Context, config *config.ConfigParams) {
+	c.options.Configure(ctx, config)
+	c.path = config.GetAsStringWithDefault("path", c.path)
+	c.maxBlobSize = config.GetAsLongWithDefault("options.max_blob_size", c.maxBlobSize)
+}
+
+func (c *BlobsFileClientV1) checkId(correlationId string, blobId string) error {
+	if blobId == "" || strings.ContainsAny(blobId, "/\\") || blobId == "." || blobId == ".." {
+		return errors.NewBadRequestError(correlationId, "INVALID_BLOB_ID",
+			"Blob id "+blobId+" is not valid").WithDetails("blob_id", blobId)
+	}
+	return nil
+}
+
+func (c *BlobsFileClientV1) getInfoFile(blobId string) string {
+	return filepath.Join(c.path, blobId+".json")
+}
+
+func (c *BlobsFileClientV1) getContentFile(blobId string) string {
+	return filepath.Join(c.path, blobId+".dat")
+}
+
+func (c *BlobsFileClientV1) getUploadInfoFile(token string) string {
+	return filepath.Join(c.path, "uploads", token+".json")
+}
+
+func (c *BlobsFileClientV1) getUploadContentFile(token string) string {
+	return filepath.Join(c.path, "uploads", token+".dat")
+}
+
+func (c *BlobsFileClientV1) getUploadRangesFile(token string) string {
+	return filepath.Join(c.path, "uploads", token+".ranges.json")
+}
+
+func (c *BlobsFileClientV1) newNotFoundError(correlationId string, blobId string) error {
+	return errors.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+		"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+}
+
+func (c *BlobsFileClientV1) newTooLargeError(correlationId string, blobId string, size int64) error {
+	return errors.NewBadRequestError(correlationId, "BLOB_TOO_LARGE",
+		"Blob "+blobId+" exceeds allowed maximum size of "+strconv.FormatInt(c.maxBlobSize, 10),
+	).WithDetails("blob_id", blobId).WithDetails("size", size).WithDetails("max_size", c.maxBlobSize)
+}
+
+// loadBlob reads blob information from the sidecar file or returns nil if it doesn't exist
+func (c *BlobsFileClientV1) loadBlob(correlationId string, blobId string) (*BlobInfoV1, error) {
+	if c.checkId(correlationId, blobId) != nil {
+		return nil, nil
+	}
+
+	return c.loadBlobFile(correlationId, c.getInfoFile(blobId))
+}
+
+func (c *BlobsFileClientV1) loadBlobFile(correlationId string, fileName string) (*BlobInfoV1, error) {
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read blob file "+fileName).WithCause(err)
+	}
+
+	blob := &BlobInfoV1{}
+	err = json.Unmarshal(buffer, blob)
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to parse blob file "+fileName).WithCause(err)
+	}
+
+	return blob, nil
+}
+
+func (c *BlobsFileClientV1) saveBlob(correlationId string, blob *BlobInfoV1) error {
+	return c.saveBlobFile(correlationId, c.getInfoFile(blob.Id), blob)
+}
+
+func (c *BlobsFileClientV1) saveBlobFile(correlationId string, fileName string, blob *BlobInfoV1) error {
+	return c.saveJsonFile(correlationId, fileName, blob)
+}
+
+func (c *BlobsFileClientV1) saveJsonFile(correlationId string, fileName string, value any) error {
+	buffer, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Dir(fileName)
+	err = os.MkdirAll(path, 0755)
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to create blobs folder "+path).WithCause(err)
+	}
+
+	// Write to a temporary file first, so a crash never leaves partial blob information
+	err = os.WriteFile(fileName+".tmp", buffer, 0644)
+	if err == nil {
+		err = os.Rename(fileName+".tmp", fileName)
+	}
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to write blob file "+fileName).WithCause(err)
+	}
+
+	return nil
+}
+
+func (c *BlobsFileClientV1) removeBlob(correlationId string, blobId string) error {
+	if c.checkId(correlationId, blobId) != nil {
+		return nil
+	}
+
+	for _, fileName := range []string{c.getContentFile(blobId), c.getInfoFile(blobId)} {
+		err := os.Remove(fileName)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.NewFileError(correlationId, "DELETE_FAILED",
+				"Failed to delete blob file "+fileName).WithCause(err)
+		}
+	}
+
+	return nil
+}
+
+// loadUploadRanges reads the parts written under the token, a new write has none
+func (c *BlobsFileClientV1) loadUploadRanges(correlationId string, token string) (*blobsWriteRanges, error) {
+	ranges := &blobsWriteRanges{}
+
+	fileName := c.getUploadRangesFile(token)
+	buffer, err := os.ReadFile(fileName)
+	if os.IsNotExist(err) {
+		return ranges, nil
+	}
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read upload file "+fileName).WithCause(err)
+	}
+
+	err = json.Unmarshal(buffer, ranges)
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to parse upload file "+fileName).WithCause(err)
+	}
+	return ranges, nil
+}
+
+// removeUpload deletes files of an unfinished write, the blob it replaces is not affected
+func (c *BlobsFileClientV1) removeUpload(correlationId string, token string) error {
+	if c.checkId(correlationId, token) != nil {
+		return nil
+	}
+
+	for _, fileName := range []string{c.getUploadContentFile(token), c.getUploadRangesFile(token),
+		c.getUploadInfoFile(token)} {
+		err := os.Remove(fileName)
+		if err != nil && !os.IsNotExist(err) {
+			return errors.NewFileError(correlationId, "DELETE_FAILED",
+				"Failed to delete upload file "+fileName).WithCause(err)
+		}
+	}
+
+	return nil
+}
+
+func (c *BlobsFileClientV1) loadBlobs(correlationId string) ([]*BlobInfoV1, error) {
+	fileNames, err := filepath.Glob(filepath.Join(c.path, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := make([]*BlobInfoV1, 0, len(fileNames))
+	for _, fileName := range fileNames {
+		blob, err := c.loadBlob(correlationId, strings.TrimSuffix(filepath.Base(fileName), ".json"))
+		if err != nil {
+			return nil, err
+		}
+		if blob != nil {
+			blobs = append(blobs, blob)
+		}
+	}
+
+	return blobs, nil
+}
+
+func (c *BlobsFileClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
+	return c.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, nil)
+}
+
+func (c *BlobsFileClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blobs, err := c.loadBlobs(correlationId)
+	if err != nil {
+		return *data.NewEmptyDataPage[*BlobInfoV1](), err
+	}
+
+	filterFunc := composeBlobsFilter(filter)
+	items := make([]*BlobInfoV1, 0)
+	for _, blob := range blobs {
+		if filterFunc(blob) {
+			items = append(items, blob)
+		}
+	}
+
+	sortBlobs(items, sort)
+
+	return pageBlobs(items, paging), nil
+}
+
+func (c *BlobsFileClientV1) GetBlobsByIds(ctx context.Context, correlationId string, blobIds []string) (result []*BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	result = make([]*BlobInfoV1, 0)
+	for _, id := range blobIds {
+		blob, err := c.loadBlob(correlationId, id)
+		if err != nil {
+			return nil, err
+		}
+		if blob != nil {
+			result = append(result, blob)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *BlobsFileClientV1) GetBlobById(ctx context.Context, correlationId string, blobId string) (result *BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.loadBlob(correlationId, blobId)
+}
+
+func (c *BlobsFileClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	uri string) (result *BlobInfoV1, err error) {
+	return BlobsUriProcessorV1.CreateBlobFromUriWithOptions(ctx, correlationId, blob, c, uri, c.options)
+}
+
+// GetBlobUriById returns file:// URI of the blob content or "" if the blob doesn't exist
+func (c *BlobsFileClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
+	blob, err := c.GetBlobById(ctx, correlationId, blobId)
+	if err != nil || blob == nil {
+		return "", err
+	}
+
+	path, err := filepath.Abs(c.getContentFile(blobId))
+	if err != nil {
+		return "", err
+	}
+
+	uri := url.URL{Scheme: "file", Path: filepath.ToSlash(path)}
+	return uri.String(), nil
+}
+
+func (c *BlobsFileClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1, buffer []byte) (result *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.CreateBlobFromDataWithOptions(ctx, correlationId, blob, c, buffer, c.options)
+}
+
+func (c *BlobsFileClientV1) GetBlobDataById(ctx context.Context, correlationId string, blobId string) (result []byte, blob *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.GetBlobDataByIdWithOptions(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobsFileClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1, stream io.Reader) (result *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, c, stream, c.options)
+}
+
+func (c *BlobsFileClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+	stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.CreateBlobFromStreamResumable(ctx, correlationId, key, blob, c, stream, c.options)
+}
+
+func (c *BlobsFileClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, c, stream, c.options)
+}
+
+func (c *BlobsFileClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.GetBlobRangeByIdWithOptions(ctx, correlationId, blobId, offset, length, c, c.options)
+}
+
+func (c *BlobsFileClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, correlationId, blobId, offset, length,
+		c, stream, c.options)
+}
+
+func (c *BlobsFileClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobsFileClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobWriter(ctx, correlationId, blob, c, c.options)
+}
+
+func (c *BlobsFileClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, c.options)
+}
+
+func (c *BlobsFileClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	oldBlob, err := c.loadBlob(correlationId, blob.Id)
+	if err != nil || oldBlob == nil {
+		return nil, err
+	}
+
+	err = c.saveBlob(correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return blob, nil
+}
+
+func (c *BlobsFileClientV1) MarkBlobsCompleted(ctx context.Context, correlationId string, blobIds []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range blobIds {
+		blob, err := c.loadBlob(correlationId, id)
+		if err != nil {
+			return err
+		}
+		if blob == nil || blob.Completed {
+			continue
+		}
+
+		blob.Completed = true
+		err = c.saveBlob(correlationId, blob)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *BlobsFileClientV1) DeleteBlobById(ctx context.Context, correlationId string, blobId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.removeBlob(correlationId, blobId)
+}
+
+func (c *BlobsFileClientV1) DeleteBlobsByIds(ctx context.Context, correlationId string, blobIds []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range blobIds {
+		err := c.removeBlob(correlationId, id)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *BlobsFileClientV1) BeginBlobWrite(ctx context.Context, correlationId string, blob *BlobInfoV1) (token string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if blob.Id == "" {
+		blob.Id = data.IdGenerator.NextLong()
+	}
+	if err = c.checkId(correlationId, blob.Id); err != nil {
+		return "", err
+	}
+
+	blob.CreateTime, _ = convert.DateTimeConverter.ToNullableDateTime(blob.CreateTime)
+	blob.ExpireTime, _ = convert.DateTimeConverter.ToNullableDateTime(blob.ExpireTime)
+	blob.Name = filepath.Base(strings.ReplaceAll(blob.Name, "\\", "/"))
+	if blob.Name == "." || blob.Name == "/" {
+		blob.Name = ""
+	}
+
+	if blob.Size > 0 && blob.Size > c.maxBlobSize {
+		return "", c.newTooLargeError(correlationId, blob.Id, blob.Size)
+	}
+
+	// Content is collected aside, so an existing blob stays readable until the write ends
+	token = data.IdGenerator.NextLong()
+	err = c.saveBlobFile(correlationId, c.getUploadInfoFile(token), blob)
+	if err != nil {
+		return "", err
+	}
+
+	fileName := c.getUploadContentFile(token)
+	err = os.WriteFile(fileName, []byte{}, 0644)
+	if err != nil {
+		c.removeUpload(correlationId, token)
+		return "", errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to create upload file "+fileName).WithCause(err)
+	}
+
+	return token, nil
+}
+
+// writeAt puts the chunk into the content file at offset, or appends it when offset is negative.
+// It must be called under the lock.
+func (c *BlobsFileClientV1) writeAt(correlationId string, token string, offset int64, chunk []byte) error {
+	if err := c.checkId(correlationId, token); err != nil {
+		return err
+	}
+
+	fileName := c.getUploadContentFile(token)
+	file, err := os.OpenFile(fileName, os.O_WRONLY, 0644)
+	if os.IsNotExist(err) {
+		return c.newNotFoundError(correlationId, token)
+	}
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to open blob file "+fileName).WithCause(err)
+	}
+	defer file.Close()
+
+	if offset < 0 {
+		offset, err = file.Seek(0, io.SeekEnd)
+		if err != nil {
+			return errors.NewFileError(correlationId, "WRITE_FAILED",
+				"Failed to write blob file "+fileName).WithCause(err)
+		}
+	}
+
+	size := offset + int64(len(chunk))
+	if c.maxBlobSize > 0 && size > c.maxBlobSize {
+		return c.newTooLargeError(correlationId, token, size)
+	}
+
+	_, err = file.WriteAt(chunk, offset)
+	if err != nil {
+		return errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to write blob file "+fileName).WithCause(err)
+	}
+
+	// Written parts are kept with the upload, so gaps are detected after restarts too
+	ranges, err := c.loadUploadRanges(correlationId, token)
+	if err != nil {
+		return err
+	}
+	ranges.add(offset, size)
+	return c.saveJsonFile(correlationId, c.getUploadRangesFile(token), ranges)
+}
+
+func (c *BlobsFileClientV1) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (token2 string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	err = c.writeAt(correlationId, token, -1, chunk)
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+func (c *BlobsFileClientV1) SupportsBlobParts(ctx context.Context, correlationId string) bool {
+	return true
+}
+
+func (c *BlobsFileClientV1) WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	// Negative offset means append for chunks, parts always have their place
+	if offset < 0 {
+		return errors.NewBadRequestError(correlationId, "INVALID_OFFSET",
+			"Offset "+strconv.FormatInt(offset, 10)+" is not valid").WithDetails("offset", offset)
+	}
+	return c.writeAt(correlationId, token, offset, chunk)
+}
+
+func (c *BlobsFileClientV1) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (offset int64, token2 string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err = c.checkId(correlationId, token); err != nil {
+		return 0, "", err
+	}
+
+	if _, err = os.Stat(c.getUploadContentFile(token)); err != nil {
+		return 0, "", c.newNotFoundError(correlationId, token)
+	}
+
+	// Content past a gap was not written continuously, so it is sent again
+	ranges, err := c.loadUploadRanges(correlationId, token)
+	if err != nil {
+		return 0, "", err
+	}
+	return ranges.Committed, token, nil
+}
+
+func (c *BlobsFileClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string, chunk []byte) (blob *BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err = c.checkId(correlationId, token); err != nil {
+		return nil, err
+	}
+	blob, err = c.loadBlobFile(correlationId, c.getUploadInfoFile(token))
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, c.newNotFoundError(correlationId, token)
+	}
+
+	// Content with missing parts is not completed, the gaps can still be written
+	fileName := c.getUploadContentFile(token)
+	info, err := os.Stat(fileName)
+	if err != nil {
+		return nil, c.newNotFoundError(correlationId, token)
+	}
+	ranges, err := c.loadUploadRanges(correlationId, token)
+	if err != nil {
+		return nil, err
+	}
+	if ranges.Committed < info.Size() {
+		return nil, errors.NewBadRequestError(correlationId, "BLOB_INCOMPLETE",
+			"Blob "+blob.Id+" is missing content after offset "+strconv.FormatInt(ranges.Committed, 10)).
+			WithDetails("blob_id", blob.Id).WithDetails("offset", ranges.Committed)
+	}
+
+	err = c.writeAt(correlationId, token, -1, chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update blob info with size, create time and content digest
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to open upload file "+fileName).WithCause(err)
+	}
+
+	checksum := newBlobsChecksum(blob.ChecksumAlgorithm)
+	size, err := io.Copy(checksum, file)
+	file.Close()
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read upload file "+fileName).WithCause(err)
+	}
+
+	blob.Size = size
+	blob.CreateTime = time.Now()
+	if checksum != nil {
+		if blob.Checksum != "" && !strings.EqualFold(blob.Checksum, checksum.sum()) {
+			c.removeUpload(correlationId, token)
+			return nil, NewBlobsIntegrityErrorV1(correlationId, blob.Id, checksum.algorithm, blob.Checksum, checksum.sum())
+		}
+		blob.Checksum = checksum.sum()
+	}
+
+	// Swap the content in and only then publish the new blob information
+	err = os.Rename(fileName, c.getContentFile(blob.Id))
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "WRITE_FAILED",
+			"Failed to replace blob file "+c.getContentFile(blob.Id)).WithCause(err)
+	}
+
+	err = c.saveBlob(correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	c.removeUpload(correlationId, token)
+	return blob, nil
+}
+
+// AbortBlobWrite drops the unfinished write, a blob with the same id written before stays intact
+func (c *BlobsFileClientV1) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.removeUpload(correlationId, token)
+}
+
+func (c *BlobsFileClientV1) BeginBlobRead(ctx context.Context, correlationId string, blobId string) (blob *BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	blob, err = c.loadBlob(correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, c.newNotFoundError(correlationId, blobId)
+	}
+
+	if _, err = os.Stat(c.getContentFile(blobId)); err != nil {
+		return nil, c.newNotFoundError(correlationId, blobId)
+	}
+
+	return blob, nil
+}
+
+func (c *BlobsFileClientV1) ReadBlobChunk(ctx context.Context, correlationId string, blobId string, skip int64, take int64) (chunk []byte, err error) {
+	if err = c.checkId(correlationId, blobId); err != nil {
+		return nil, err
+	}
+
+	fileName := c.getContentFile(blobId)
+	file, err := os.Open(fileName)
+	if os.IsNotExist(err) {
+		return nil, c.newNotFoundError(correlationId, blobId)
+	}
+	if err != nil {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to open blob file "+fileName).WithCause(err)
+	}
+	defer file.Close()
+
+	if take < 0 {
+		take = 0
+	}
+	chunk = make([]byte, take)
+	n, err := file.ReadAt(chunk, skip)
+	if err != nil && err != io.EOF {
+		return nil, errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read blob file "+fileName).WithCause(err)
+	}
+
+	return chunk[:n], nil
+}
+
+func (c *BlobsFileClientV1) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+package version1
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+	"sync"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	"github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobsFileKeyProviderV1 wraps data keys with AES-GCM using a 256-bit master key kept in a local file.
+// The file holds the key as 32 raw bytes, 64 hex characters or base64.
+// Key id is a fingerprint of the master key, so blobs wrapped by another key are detected.
+//
+//	Configuration parameters:
+//		- encryption:
+//			- key_file: path to the master key file
+type BlobsFileKeyProviderV1 struct {
+	path  string
+	lock  sync.Mutex
+	keyId string
+	aead  cipher.AEAD
+}
+
+func NewBlobsFileKeyProviderV1(path string) *BlobsFileKeyProviderV1 {
+	return &BlobsFileKeyProviderV1{
+		path: path,
+	}
+}
+
+func (c *BlobsFileKeyProviderV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	path := config.GetAsStringWithDefault("encryption.key_file", c.path)
+	if path != c.path {
+		c.path = path
+		c.aead = nil
+	}
+}
+
+// loadKey reads the master key at first use, it must be called under the lock
+func (c *BlobsFileKeyProviderV1) loadKey(correlationId string) error {
+	if c.aead != nil {
+		return nil
+	}
+	if c.path == "" {
+		return errors.NewConfigError(correlationId, "NO_KEY_FILE", "Master key file is not set")
+	}
+
+	buffer, err := os.ReadFile(c.path)
+	if err != nil {
+		return errors.NewFileError(correlationId, "READ_FAILED",
+			"Failed to read master key file "+c.path).WithCause(err)
+	}
+
+	key := buffer
+	if len(key) != 32 {
+		text := strings.TrimSpace(string(buffer))
+		if key, err = hex.DecodeString(text); err != nil || len(key) != 32 {
+			key, err = base64.StdEncoding.DecodeString(text)
+		}
+	}
+	if err != nil || len(key) != 32 {
+		return errors.NewConfigError(correlationId, "INVALID_KEY",
+			"Master key file "+c.path+" must contain 256-bit key")
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	c.aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(key)
+	c.keyId = hex.EncodeToString(hash[:8])
+	return nil
+}
+
+func (c *BlobsFileKeyProviderV1) WrapKey(ctx context.Context, correlationId string, key []byte) (string, []byte, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.loadKey(correlationId); err != nil {
+		return "", nil, err
+	}
+
+	// Random nonce goes in front of the wrapped key
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return c.keyId, c.aead.Seal(nonce, nonce, key, []byte(c.keyId)), nil
+}
+
+func (c *BlobsFileKeyProviderV1) UnwrapKey(ctx context.Context, correlationId string, keyId string, wrappedKey []byte) ([]byte, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.loadKey(correlationId); err != nil {
+		return nil, err
+	}
+
+	if keyId != c.keyId || len(wrappedKey) < c.aead.NonceSize() {
+		return nil, errors.NewBadRequestError(correlationId, "WRONG_KEY",
+			"Data key was wrapped by unknown master key "+keyId).WithDetails("key_id", keyId)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	key, err := c.aead.Open(nil, wrappedKey[:nonceSize], wrappedKey[nonceSize:], []byte(keyId))
+	if err != nil {
+		return nil, errors.NewBadRequestError(correlationId, "WRONG_KEY",
+			"Failed to unwrap data key").WithDetails("key_id", keyId).WithCause(err)
+	}
+	return key, nil
+}
@@ -0,0 +1,188 @@
+package version1
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+)
+
+func matchBlobString(value string, search string) bool {
+	if value == "" && search == "" {
+		return true
+	}
+	if value == "" || search == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(value), strings.ToLower(search))
+}
+
+func matchBlobSearch(item *BlobInfoV1, search string) bool {
+	search = strings.ToLower(search)
+	return matchBlobString(item.Name, search)
+}
+
+func matchBlobTag(tags []string, tag string) bool {
+	for _, v := range tags {
+		if data.TagsProcessor.EqualTags(v, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// composeBlobsFilter converts filter parameters into a predicate used by the local clients
+func composeBlobsFilter(filter *data.FilterParams) func(item *BlobInfoV1) bool {
+	if filter == nil {
+		filter = data.NewEmptyFilterParams()
+	}
+
+	search := filter.GetAsString("search")
+	id := filter.GetAsString("id")
+	name := filter.GetAsString("name")
+	group := filter.GetAsString("group")
+	completed, completedOk := filter.GetAsNullableBoolean("completed")
+	expired, expiredOk := filter.GetAsNullableBoolean("expired")
+	fromCreateTime, fromCreateTimeOK := filter.GetAsNullableDateTime("from_create_time")
+	toCreateTime, toCreateTimeOk := filter.GetAsNullableDateTime("to_create_time")
+	tag := filter.GetAsString("tag")
+
+	// Metadata is filtered by meta.<key> parameters
+	meta := make(map[string]string)
+	for _, key := range filter.Keys() {
+		if strings.HasPrefix(key, "meta.") {
+			meta[key[len("meta."):]] = filter.GetAsString(key)
+		}
+	}
+
+	now := time.Now()
+
+	return func(item *BlobInfoV1) bool {
+		if search != "" && !matchBlobSearch(item, search) {
+			return false
+		}
+		if id != "" && id != item.Id {
+			return false
+		}
+		if name != "" && name != item.Name {
+			return false
+		}
+		if group != "" && group != item.Group {
+			return false
+		}
+		if completedOk && completed != item.Completed {
+			return false
+		}
+		if expiredOk && expired && item.ExpireTime.Unix() > now.Unix() {
+			return false
+		}
+		if expiredOk && !expired && item.ExpireTime.Unix() <= now.Unix() {
+			return false
+		}
+		if fromCreateTimeOK && item.CreateTime.Unix() >= fromCreateTime.Unix() {
+			return false
+		}
+		if toCreateTimeOk && item.CreateTime.Unix() < toCreateTime.Unix() {
+			return false
+		}
+		if tag != "" && !matchBlobTag(item.Tags, tag) {
+			return false
+		}
+		for key, value := range meta {
+			if itemValue, ok := item.Metadata[key]; !ok || itemValue != value {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// pageBlobs takes the requested page from the items, without paging all items are returned.
+// Zero take means the default page size, as in the blobs service.
+func pageBlobs(items []*BlobInfoV1, paging *data.PagingParams) data.DataPage[*BlobInfoV1] {
+	if paging == nil {
+		return *data.NewDataPage(items, len(items))
+	}
+
+	total := len(items)
+	skip := int(paging.GetSkip(0))
+	take := int(paging.GetTake(int64(total)))
+	if paging.Take == 0 {
+		take = int(data.DefaultTake)
+	}
+	if skip > len(items) {
+		skip = len(items)
+	}
+	items = items[skip:]
+	if take < len(items) {
+		items = items[:take]
+	}
+
+	if paging.Total {
+		return *data.NewDataPage(items, total)
+	}
+	return *data.NewDataPage(items, data.EmptyTotalValue)
+}
+
+// compareBlobs compares two blobs by the field, unknown fields are considered equal
+func compareBlobs(a *BlobInfoV1, b *BlobInfoV1, field string) int {
+	compareTimes := func(a time.Time, b time.Time) int {
+		if a.Before(b) {
+			return -1
+		} else if a.After(b) {
+			return 1
+		}
+		return 0
+	}
+
+	switch strings.ToLower(field) {
+	case "id":
+		return strings.Compare(a.Id, b.Id)
+	case "group":
+		return strings.Compare(a.Group, b.Group)
+	case "name":
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	case "content_type":
+		return strings.Compare(a.ContentType, b.ContentType)
+	case "size":
+		if a.Size < b.Size {
+			return -1
+		} else if a.Size > b.Size {
+			return 1
+		}
+		return 0
+	case "create_time":
+		return compareTimes(a.CreateTime, b.CreateTime)
+	case "expire_time":
+		return compareTimes(a.ExpireTime, b.ExpireTime)
+	case "completed":
+		if a.Completed == b.Completed {
+			return 0
+		} else if b.Completed {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortBlobs orders the items by the sort fields, or by create time when no fields are given.
+// The sort is stable, so items equal by all fields keep their original order.
+func sortBlobs(items []*BlobInfoV1, sortParams *data.SortParams) {
+	fields := data.SortParams{data.NewSortField("create_time", true)}
+	if sortParams != nil && len(*sortParams) > 0 {
+		fields = *sortParams
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		for _, field := range fields {
+			result := compareBlobs(items[i], items[j], field.Name)
+			if result != 0 {
+				return (result < 0) == field.Ascending
+			}
+		}
+		return false
+	})
+}
@@ -1,36 +1,328 @@
 package version1
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"io"
+	"time"
 
 	"github.com/pip-services-infrastructure2/client-blobs-go/protos"
 	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
 	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
 	"github.com/pip-services3-gox/pip-services3-grpc-gox/clients"
 )
 
 type BlobGrpcClientV1 struct {
 	*clients.GrpcClient
-	chunkSize int
+	options   *BlobsTransferOptionsV1
+	streaming bool
+	features  blobsFeatures
 }
 
 func NewBlobGrpcClientV1() *BlobGrpcClientV1 {
 	return &BlobGrpcClientV1{
 		GrpcClient: clients.NewGrpcClient("blobs_v1.Blobs"),
-		chunkSize:  10240,
+		options:    NewBlobsTransferOptionsV1(),
+		streaming:  true,
 	}
 }
 
 func (c *BlobGrpcClientV1) Configure(ctx context.Context, config *config.ConfigParams) {
 	c.GrpcClient.Configure(ctx, config)
 
-	c.chunkSize = config.GetAsIntegerWithDefault("options.chunk_size", c.chunkSize)
+	c.options.Configure(ctx, config)
+	c.streaming = config.GetAsBooleanWithDefault("options.streaming", c.streaming)
+}
+
+func (c *BlobGrpcClientV1) Close(ctx context.Context, correlationId string) error {
+	// Features are probed again on the next connection
+	c.features.reset()
+
+	return c.GrpcClient.Close(ctx, correlationId)
+}
+
+func (c *BlobGrpcClientV1) GetFeatures(ctx context.Context, correlationId string) (result []string, err error) {
+	timing := c.Instrument(ctx, correlationId, "blobs_v1.get_features")
+	defer timing.EndTiming(ctx, err)
+
+	req := &protos.BlobFeaturesRequest{
+		CorrelationId: correlationId,
+	}
+
+	reply := new(protos.BlobFeaturesReply)
+	err = c.CallWithContext(ctx, "get_features", correlationId, req, reply)
+	if err != nil {
+		return nil, err
+	}
+
+	if reply.Error != nil {
+		err = toError(reply.Error)
+		return nil, err
+	}
+
+	result = reply.Features
+
+	return result, nil
+}
+
+// supportsStreaming checks if the server accepts the streaming call
+func (c *BlobGrpcClientV1) supportsStreaming(ctx context.Context, correlationId string, feature string) bool {
+	return c.streaming && c.features.supports(ctx, correlationId, feature, c.GetFeatures)
+}
+
+func (c *BlobGrpcClientV1) uploadBlob(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	stream io.Reader) (result *BlobInfoV1, err error) {
+	timing := c.Instrument(ctx, correlationId, "blobs_v1.upload_blob")
+	defer timing.EndTiming(ctx, err)
+
+	// Generate blob id
+	if blob.Id == "" {
+		blob.Id = data.IdGenerator.NextLong()
+	}
+	blob.CreateTime = time.Now()
+
+	// A broken stream loses the content sent so far, so the whole upload is repeated
+	// and only when the content can be read again
+	seeker, ok := stream.(io.Seeker)
+	if !ok {
+		return c.sendBlob(ctx, correlationId, blob, stream)
+	}
+	start, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return c.sendBlob(ctx, correlationId, blob, stream)
+	}
+
+	// Every attempt starts from the blob of the caller, as encoding marks the sent one
+	request := *blob
+	err = c.options.getRetries().retry(ctx, func(attempt int) error {
+		if attempt > 0 {
+			if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+				return err
+			}
+		}
+		attemptBlob := request
+		var err error
+		result, err = c.sendBlob(ctx, correlationId, &attemptBlob, stream)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// sendBlob makes a single attempt to upload the blob through the streaming call
+func (c *BlobGrpcClientV1) sendBlob(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	stream io.Reader) (*BlobInfoV1, error) {
+
+	// Content type is checked before anything is sent
+	stream, err := prepareBlobStreamContentType(correlationId, blob, stream, c.options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compress the content on the fly
+	codec, err := prepareBlobEncoding(correlationId, blob, c.options)
+	if err != nil {
+		return nil, err
+	}
+	if codec != nil {
+		encoded := encodeBlobStream(codec, stream)
+		defer encoded.Close()
+		stream = encoded
+	}
+
+	// Cancelled stream tells the server to abort the blob, while closed stream commits it
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	upload, err := protos.NewBlobsClient(c.Connection).UploadBlob(streamCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Digest is calculated as content goes through
+	checksum := c.options.newChecksum()
+	checksum.prepare(blob)
+	stream = io.TeeReader(stream, checksum)
+
+	// Size of compressed content is not known in advance
+	total := int64(-1)
+	if codec == nil && blob.Size > 0 {
+		total = blob.Size
+	}
+	progress := newBlobsProgress(ctx, correlationId, BlobsTransferUpload, blob.Id, total, c.options)
+
+	// Send blob info first
+	err = upload.Send(&protos.BlobUploadRequest{
+		CorrelationId: correlationId,
+		Blob:          fromBlobInfo(blob),
+	})
+
+	// Send content in chunks
+	buffer := make([]byte, c.options.getChunkSize())
+	offset := int64(0)
+	for err == nil {
+		size, err1 := io.ReadFull(stream, buffer)
+		if size > 0 {
+			// Stop between chunks when the transfer is cancelled
+			err = ctx.Err()
+			if err == nil {
+				err = c.options.getUploadLimiter().Wait(ctx, size)
+			}
+			if err != nil {
+				cancel()
+				return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err)
+			}
+			err = upload.Send(&protos.BlobUploadRequest{
+				CorrelationId: correlationId,
+				Chunk:         buffer[0:size],
+			})
+			if err == nil {
+				offset += int64(size)
+				progress.add(size)
+			}
+		}
+
+		if err1 == io.EOF || err1 == io.ErrUnexpectedEOF {
+			break
+		}
+		if err1 != nil {
+			cancel()
+			return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err1)
+		}
+	}
+
+	// io.EOF means the server closed the stream, its reply has the reason
+	if err != nil && err != io.EOF {
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err)
+	}
+
+	reply, err := upload.CloseAndRecv()
+	if err != nil {
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err)
+	}
+
+	if reply.Error != nil {
+		return nil, toError(reply.Error)
+	}
+
+	result := toBlobInfo(reply.Blob)
+	return progress.complete(checksum.complete(ctx, correlationId, c, result))
+}
+
+func (c *BlobGrpcClientV1) downloadBlob(ctx context.Context, correlationId string, blobId string,
+	stream io.Writer) (result *BlobInfoV1, err error) {
+	timing := c.Instrument(ctx, correlationId, "blobs_v1.download_blob")
+	defer timing.EndTiming(ctx, err)
+
+	req := &protos.BlobIdRequest{
+		CorrelationId: correlationId,
+		BlobId:        blobId,
+	}
+
+	// Stream is cancelled when the download stops early, so it is released on the server
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	download, err := protos.NewBlobsClient(c.Connection).DownloadBlob(streamCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var checksum *blobsChecksum
+	var decoder *blobsDecodingWriter
+	var progress *blobsProgress
+	received := int64(0)
+	defer func() {
+		if decoder != nil {
+			decoder.CloseWithError(io.ErrUnexpectedEOF)
+		}
+	}()
+
+	for {
+		reply, err := download.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if reply.Error != nil {
+			err = toError(reply.Error)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Blob info comes with the first message
+		if reply.Blob != nil {
+			result = toBlobInfo(reply.Blob)
+			checksum = newBlobsChecksumForRead(result)
+			progress = newBlobsProgress(ctx, correlationId, BlobsTransferDownload, blobId, result.Size, c.options)
+
+			// Decompress the content as it comes
+			codec, err := getBlobDecoding(correlationId, result, c.options)
+			if err != nil {
+				return nil, err
+			}
+			if codec != nil {
+				decoder = newBlobsDecodingWriter(codec, stream)
+				stream = decoder
+			}
+		}
+
+		if len(reply.Chunk) > 0 {
+			err = c.options.getDownloadLimiter().Wait(ctx, len(reply.Chunk))
+			if err != nil {
+				return nil, err
+			}
+			_, err = stream.Write(reply.Chunk)
+			if err != nil {
+				return nil, err
+			}
+			checksum.Write(reply.Chunk)
+			received += int64(len(reply.Chunk))
+			progress.add(len(reply.Chunk))
+		}
+	}
+
+	if result == nil {
+		err = cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+		return nil, err
+	}
+
+	// Stream that ended early must not pass for the whole blob
+	if received != result.Size {
+		err = NewBlobsTruncatedErrorV1(correlationId, blobId, result.Size, received)
+		return nil, err
+	}
+
+	err = checksum.verify(correlationId, result)
+	if err != nil {
+		return nil, err
+	}
+	if decoder != nil {
+		if err = decoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	progress.done()
+
+	return result, nil
 }
 
 func (c *BlobGrpcClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams,
 	paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
+	return c.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, nil)
+}
+
+func (c *BlobGrpcClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams,
+	paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
 	timing := c.Instrument(ctx, correlationId, "blobs_v1.get_blobs_by_filter")
 	defer timing.EndTiming(ctx, err)
 
@@ -47,6 +339,7 @@ func (c *BlobGrpcClientV1) GetBlobsByFilter(ctx context.Context, correlationId s
 			Total: paging.Total,
 		}
 	}
+	req.Sort = fromSortParams(sort)
 
 	reply := new(protos.BlobInfoPageReply)
 	err = c.CallWithContext(ctx, "get_blobs_by_filter", correlationId, req, reply)
@@ -116,7 +409,7 @@ func (c *BlobGrpcClientV1) GetBlobById(ctx context.Context, correlationId string
 
 func (c *BlobGrpcClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	uri string) (result *BlobInfoV1, err error) {
-	return BlobsUriProcessorV1.CreateBlobFromUri(ctx, correlationId, blob, c, uri, c.chunkSize)
+	return BlobsUriProcessorV1.CreateBlobFromUriWithOptions(ctx, correlationId, blob, c, uri, c.options)
 }
 
 func (c *BlobGrpcClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
@@ -146,22 +439,67 @@ func (c *BlobGrpcClientV1) GetBlobUriById(ctx context.Context, correlationId str
 
 func (c *BlobGrpcClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	buffer []byte) (*BlobInfoV1, error) {
-	return BlobsDataProcessorV1.CreateBlobFromData(ctx, correlationId, blob, c, buffer, c.chunkSize)
+	if c.supportsStreaming(ctx, correlationId, BlobsFeatureUploadBlob) {
+		return c.uploadBlob(ctx, correlationId, blob, bytes.NewReader(buffer))
+	}
+	return BlobsDataProcessorV1.CreateBlobFromDataWithOptions(ctx, correlationId, blob, c, buffer, c.options)
 }
 
 func (c *BlobGrpcClientV1) GetBlobDataById(ctx context.Context, correlationId string,
 	blobId string) ([]byte, *BlobInfoV1, error) {
-	return BlobsDataProcessorV1.GetBlobDataById(ctx, correlationId, blobId, c, c.chunkSize)
+	if c.supportsStreaming(ctx, correlationId, BlobsFeatureDownloadBlob) {
+		buffer := &bytes.Buffer{}
+		blob, err := c.downloadBlob(ctx, correlationId, blobId, buffer)
+		if err != nil {
+			return nil, nil, err
+		}
+		return buffer.Bytes(), blob, nil
+	}
+	return BlobsDataProcessorV1.GetBlobDataByIdWithOptions(ctx, correlationId, blobId, c, c.options)
 }
 
 func (c *BlobGrpcClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	stream io.Reader) (*BlobInfoV1, error) {
-	return BlobsStreamProcessorV1.CreateBlobFromStream(ctx, correlationId, blob, c, stream, c.chunkSize)
+	if c.supportsStreaming(ctx, correlationId, BlobsFeatureUploadBlob) {
+		return c.uploadBlob(ctx, correlationId, blob, stream)
+	}
+	return BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, c, stream, c.options)
+}
+
+func (c *BlobGrpcClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+	stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.CreateBlobFromStreamResumable(ctx, correlationId, key, blob, c, stream, c.options)
 }
 
 func (c *BlobGrpcClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string,
 	stream io.Writer) (*BlobInfoV1, error) {
-	return BlobsStreamProcessorV1.GetBlobStreamById(ctx, correlationId, blobId, c, stream, c.chunkSize)
+	if c.supportsStreaming(ctx, correlationId, BlobsFeatureDownloadBlob) {
+		return c.downloadBlob(ctx, correlationId, blobId, stream)
+	}
+	return BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, c, stream, c.options)
+}
+
+func (c *BlobGrpcClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.GetBlobRangeByIdWithOptions(ctx, correlationId, blobId, offset, length, c, c.options)
+}
+
+func (c *BlobGrpcClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, correlationId, blobId, offset, length,
+		c, stream, c.options)
+}
+
+func (c *BlobGrpcClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobGrpcClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobWriter(ctx, correlationId, blob, c, c.options)
+}
+
+func (c *BlobGrpcClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, c.options)
 }
 
 func (c *BlobGrpcClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
@@ -384,6 +722,36 @@ func (c *BlobGrpcClientV1) WriteBlobChunk(ctx context.Context, correlationId str
 	return result, nil
 }
 
+// SupportsBlobParts checks if the server advertises write_blob_part
+func (c *BlobGrpcClientV1) SupportsBlobParts(ctx context.Context, correlationId string) bool {
+	return c.features.supports(ctx, correlationId, BlobsFeatureWriteBlobPart, c.GetFeatures)
+}
+
+func (c *BlobGrpcClientV1) WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) (err error) {
+	timing := c.Instrument(ctx, correlationId, "blobs_v1.write_blob_part")
+	defer timing.EndTiming(ctx, err)
+
+	req := &protos.BlobPartRequest{
+		CorrelationId: correlationId,
+		Token:         token,
+		Offset:        offset,
+		Chunk:         chunk,
+	}
+
+	reply := new(protos.BlobEmptyReply)
+	err = c.CallWithContext(ctx, "write_blob_part", correlationId, req, reply)
+	if err != nil {
+		return err
+	}
+
+	if reply.Error != nil {
+		err = toError(reply.Error)
+		return err
+	}
+
+	return nil
+}
+
 func (c *BlobGrpcClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string, chunk []byte) (result *BlobInfoV1, err error) {
 	timing := c.Instrument(ctx, correlationId, "blobs_v1.end_blob_write")
 	defer timing.EndTiming(ctx, err)
@@ -411,6 +779,32 @@ func (c *BlobGrpcClientV1) EndBlobWrite(ctx context.Context, correlationId strin
 
 }
 
+func (c *BlobGrpcClientV1) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (result int64, token2 string, err error) {
+	timing := c.Instrument(ctx, correlationId, "blobs_v1.get_blob_write_offset")
+	defer timing.EndTiming(ctx, err)
+
+	req := &protos.BlobTokenRequest{
+		CorrelationId: correlationId,
+		Token:         token,
+	}
+
+	reply := new(protos.BlobOffsetReply)
+	err = c.CallWithContext(ctx, "get_blob_write_offset", correlationId, req, reply)
+	if err != nil {
+		return 0, "", err
+	}
+
+	if reply.Error != nil {
+		err = toError(reply.Error)
+		return 0, "", err
+	}
+
+	result = reply.Offset
+	token2 = reply.Token
+
+	return result, token2, nil
+}
+
 func (c *BlobGrpcClientV1) AbortBlobWrite(ctx context.Context, correlationId string, token string) (err error) {
 	timing := c.Instrument(ctx, correlationId, "blobs_v1.abort_blob_write")
 	defer timing.EndTiming(ctx, err)
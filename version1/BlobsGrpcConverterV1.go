@@ -50,6 +50,18 @@ func toError(obj *protos.ErrorDescription) error {
 	return errors.ApplicationErrorFactory.Create(description)
 }
 
+func fromSortParams(sort *data.SortParams) []*protos.SortField {
+	if sort == nil {
+		return nil
+	}
+
+	result := make([]*protos.SortField, len(*sort))
+	for i, field := range *sort {
+		result[i] = &protos.SortField{Name: field.Name, Ascending: field.Ascending}
+	}
+	return result
+}
+
 func fromMap(val map[string]any) map[string]string {
 	r := map[string]string{}
 
@@ -106,6 +118,14 @@ func fromBlobInfo(blob *BlobInfoV1) *protos.BlobInfo {
 		CreateTime:  convert.StringConverter.ToString(blob.CreateTime),
 		ExpireTime:  convert.StringConverter.ToString(blob.ExpireTime),
 		Completed:   blob.Completed,
+
+		ChecksumAlgorithm: blob.ChecksumAlgorithm,
+		Checksum:          blob.Checksum,
+
+		Metadata: blob.Metadata,
+		Tags:     blob.Tags,
+
+		ContentEncoding: blob.ContentEncoding,
 	}
 
 	return obj
@@ -125,6 +145,14 @@ func toBlobInfo(obj *protos.BlobInfo) *BlobInfoV1 {
 		CreateTime:  convert.DateTimeConverter.ToDateTime(obj.CreateTime),
 		ExpireTime:  convert.DateTimeConverter.ToDateTime(obj.ExpireTime),
 		Completed:   obj.Completed,
+
+		ChecksumAlgorithm: obj.ChecksumAlgorithm,
+		Checksum:          obj.Checksum,
+
+		Metadata: obj.Metadata,
+		Tags:     obj.Tags,
+
+		ContentEncoding: obj.ContentEncoding,
 	}
 
 	return blob
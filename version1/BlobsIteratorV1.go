@@ -0,0 +1,149 @@
+package version1
+
+import (
+	"context"
+
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+)
+
+// BlobsIteratorV1 enumerates blobs that match a filter page by page.
+// Pages are requested lazily and the next page is prefetched while the current one is consumed.
+// The iterator is not safe for concurrent use.
+//
+//	iterator := client.ListBlobs(ctx, correlationId, filter)
+//	defer iterator.Close()
+//	for iterator.Next() {
+//		blob := iterator.Blob()
+//	}
+//	if err := iterator.Err(); err != nil {
+//		...
+//	}
+type BlobsIteratorV1 struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	correlationId string
+	client        IBlobsClientV1
+	filter        *data.FilterParams
+	pageSize      int
+	started       bool
+	skip          int64
+	total         int64
+	page          []*BlobInfoV1
+	index         int
+	blob          *BlobInfoV1
+	next          chan blobsPageResult
+	err           error
+}
+
+type blobsPageResult struct {
+	items []*BlobInfoV1
+	total int64
+	err   error
+}
+
+// NewBlobsIteratorV1 creates an iterator over blobs of any client.
+// The page size is taken from the transfer options.
+func NewBlobsIteratorV1(ctx context.Context, correlationId string, client IBlobsClientV1,
+	filter *data.FilterParams, options *BlobsTransferOptionsV1) *BlobsIteratorV1 {
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &BlobsIteratorV1{
+		ctx:           ctx,
+		cancel:        cancel,
+		correlationId: correlationId,
+		client:        client,
+		filter:        filter,
+		pageSize:      options.getPageSize(),
+		total:         -1,
+	}
+}
+
+// Next advances to the next blob and returns false when there are no more blobs or an error occurred
+func (c *BlobsIteratorV1) Next() bool {
+	if !c.started {
+		c.started = true
+		c.next = c.fetch(0)
+	}
+
+	for {
+		if c.err != nil {
+			c.blob = nil
+			return false
+		}
+
+		if c.index < len(c.page) {
+			c.blob = c.page[c.index]
+			c.index++
+			return true
+		}
+
+		if c.next == nil {
+			c.blob = nil
+			return false
+		}
+
+		var result blobsPageResult
+		select {
+		case result = <-c.next:
+		case <-c.ctx.Done():
+			result = blobsPageResult{err: c.ctx.Err()}
+		}
+		c.next = nil
+
+		if result.err != nil {
+			c.err = result.err
+			continue
+		}
+
+		c.page = result.items
+		c.index = 0
+		c.skip += int64(len(result.items))
+		if result.total >= 0 {
+			c.total = result.total
+		}
+
+		// Services may return pages shorter than requested,
+		// so only an empty page or the reached total ends the iteration
+		if len(result.items) > 0 && (c.total < 0 || c.skip < c.total) {
+			c.next = c.fetch(c.skip)
+		}
+	}
+}
+
+// Blob returns the current blob
+func (c *BlobsIteratorV1) Blob() *BlobInfoV1 {
+	return c.blob
+}
+
+// Err returns the error that stopped the iteration
+func (c *BlobsIteratorV1) Err() error {
+	return c.err
+}
+
+// Close stops the iteration and cancels the prefetched page
+func (c *BlobsIteratorV1) Close() error {
+	c.cancel()
+	c.started = true
+	c.next = nil
+	c.page = nil
+	c.blob = nil
+	return nil
+}
+
+func (c *BlobsIteratorV1) fetch(skip int64) chan blobsPageResult {
+	// Buffered, so the request never blocks after the iterator was closed
+	result := make(chan blobsPageResult, 1)
+
+	// Total is requested only once, as counting may be expensive for the service
+	go func() {
+		page, err := c.client.GetBlobsByFilter(c.ctx, c.correlationId, c.filter,
+			data.NewPagingParams(skip, int64(c.pageSize), skip == 0))
+		total := int64(-1)
+		if err == nil && page.HasTotal() {
+			total = int64(page.Total)
+		}
+		result <- blobsPageResult{items: page.Data, total: total, err: err}
+	}()
+
+	return result
+}
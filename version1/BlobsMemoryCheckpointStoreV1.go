@@ -0,0 +1,45 @@
+package version1
+
+import (
+	"context"
+	"sync"
+)
+
+type BlobsMemoryCheckpointStoreV1 struct {
+	checkpoints map[string]BlobsUploadCheckpointV1
+	lock        sync.Mutex
+}
+
+func NewBlobsMemoryCheckpointStoreV1() *BlobsMemoryCheckpointStoreV1 {
+	return &BlobsMemoryCheckpointStoreV1{
+		checkpoints: make(map[string]BlobsUploadCheckpointV1),
+	}
+}
+
+func (c *BlobsMemoryCheckpointStoreV1) LoadCheckpoint(ctx context.Context, correlationId string, key string) (checkpoint *BlobsUploadCheckpointV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	buf, ok := c.checkpoints[key]
+	if !ok {
+		return nil, nil
+	}
+
+	return &buf, nil
+}
+
+func (c *BlobsMemoryCheckpointStoreV1) SaveCheckpoint(ctx context.Context, correlationId string, checkpoint *BlobsUploadCheckpointV1) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.checkpoints[checkpoint.Key] = *checkpoint
+	return nil
+}
+
+func (c *BlobsMemoryCheckpointStoreV1) DeleteCheckpoint(ctx context.Context, correlationId string, key string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.checkpoints, key)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pip-services3-gox/pip-services3-commons-gox/config"
@@ -13,112 +14,91 @@ import (
 	"github.com/pip-services3-gox/pip-services3-commons-gox/errors"
 )
 
+// blobsMockWrite is content of a blob that is being written under a token
+type blobsMockWrite struct {
+	blobId string
+	buffer []byte
+	ranges blobsWriteRanges
+}
+
+// BlobsMockClientV1 is a concurrent in-memory blobs store.
+// Blobs are indexed by id and every write gets its own token,
+// so parallel uploads never touch each other's buffers.
 type BlobsMockClientV1 struct {
-	blobs       []*BlobInfoV1
-	chunkSize   int64
+	options     *BlobsTransferOptionsV1
 	maxBlobSize int64
+	lock        sync.RWMutex
+	blobs       map[string]*BlobInfoV1
+	ids         []string
 	content     map[string][]byte
+	writes      map[string]*blobsMockWrite
 }
 
 func NewBlobsMockClientV1() *BlobsMockClientV1 {
 	return &BlobsMockClientV1{
-		chunkSize:   10240,
-		blobs:       make([]*BlobInfoV1, 0),
+		options:     NewBlobsTransferOptionsV1(),
 		maxBlobSize: 100 * 1024,
-		content:     make(map[string][]byte, 0),
+		blobs:       make(map[string]*BlobInfoV1),
+		ids:         make([]string, 0),
+		content:     make(map[string][]byte),
+		writes:      make(map[string]*blobsMockWrite),
 	}
 }
 
 func (c *BlobsMockClientV1) Configure(ctx context.Context, config *config.ConfigParams) {
-	c.chunkSize = config.GetAsLongWithDefault("options.chunk_size", c.chunkSize)
+	c.options.Configure(ctx, config)
 	c.maxBlobSize = config.GetAsLongWithDefault("options.max_blob_size", c.maxBlobSize)
 }
 
-func (c *BlobsMockClientV1) matchString(value string, search string) bool {
-	if value == "" && search == "" {
-		return true
-	}
-	if value == "" || search == "" {
-		return false
-	}
-	return strings.Contains(strings.ToLower(value), strings.ToLower(search))
-}
-
-func (c *BlobsMockClientV1) matchSearch(item *BlobInfoV1, search string) bool {
-	search = strings.ToLower(search)
-	return c.matchString(item.Name, search)
-}
-
-func (c *BlobsMockClientV1) composeFilter(filter *data.FilterParams) func(item *BlobInfoV1) bool {
-	if filter == nil {
-		filter = data.NewEmptyFilterParams()
+// cloneBlob copies the blob, so callers never share state with the store
+func (c *BlobsMockClientV1) cloneBlob(blob *BlobInfoV1) *BlobInfoV1 {
+	if blob == nil {
+		return nil
 	}
 
-	search := filter.GetAsString("search")
-	id := filter.GetAsString("id")
-	name := filter.GetAsString("name")
-	group := filter.GetAsString("group")
-	completed, completedOk := filter.GetAsNullableBoolean("completed")
-	expired, expiredOk := filter.GetAsNullableBoolean("expired")
-	fromCreateTime, fromCreateTimeOK := filter.GetAsNullableDateTime("from_create_time")
-	toCreateTime, toCreateTimeOk := filter.GetAsNullableDateTime("to_create_time")
-
-	now := time.Now()
-
-	return func(item *BlobInfoV1) bool {
-		if search != "" && !c.matchSearch(item, search) {
-			return false
-		}
-		if id != "" && id != item.Id {
-			return false
-		}
-		if name != "" && name != item.Name {
-			return false
-		}
-		if group != "" && group != item.Group {
-			return false
+	result := *blob
+	if blob.Metadata != nil {
+		result.Metadata = make(map[string]string, len(blob.Metadata))
+		for k, v := range blob.Metadata {
+			result.Metadata[k] = v
 		}
-		if completedOk && completed != item.Completed {
-			return false
-		}
-		if expiredOk && expired && item.ExpireTime.Unix() > now.Unix() {
-			return false
-		}
-		if expiredOk && !expired && item.ExpireTime.Unix() <= now.Unix() {
-			return false
-		}
-		if fromCreateTimeOK && item.CreateTime.Unix() >= fromCreateTime.Unix() {
-			return false
-		}
-		if toCreateTimeOk && item.CreateTime.Unix() < toCreateTime.Unix() {
-			return false
-		}
-		return true
 	}
+	if blob.Tags != nil {
+		result.Tags = append([]string{}, blob.Tags...)
+	}
+	return &result
 }
 
 func (c *BlobsMockClientV1) GetBlobsByFilter(ctx context.Context, correlationId string, filter *data.FilterParams, paging *data.PagingParams) (result data.DataPage[*BlobInfoV1], err error) {
-	filterFunc := c.composeFilter(filter)
+	return c.GetBlobsByFilterWithSort(ctx, correlationId, filter, paging, nil)
+}
+
+func (c *BlobsMockClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams, paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	filterFunc := composeBlobsFilter(filter)
 
 	items := make([]*BlobInfoV1, 0)
-	for _, v := range c.blobs {
-		item := *v
-		if filterFunc(&item) {
-			items = append(items, &item)
+	for _, id := range c.ids {
+		item := c.cloneBlob(c.blobs[id])
+		if filterFunc(item) {
+			items = append(items, item)
 		}
 	}
-	return *data.NewDataPage(items, len(c.blobs)), nil
+
+	sortBlobs(items, sort)
+	return pageBlobs(items, paging), nil
 }
 
 func (c *BlobsMockClientV1) GetBlobsByIds(ctx context.Context, correlationId string, blobIds []string) (result []*BlobInfoV1, err error) {
-	result = make([]*BlobInfoV1, 0)
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	for _, b := range c.blobs {
-		for _, id := range blobIds {
-			if id == b.Id {
-				buf := *b
-				result = append(result, &buf)
-			}
+	result = make([]*BlobInfoV1, 0)
+	for _, id := range blobIds {
+		if blob, ok := c.blobs[id]; ok {
+			result = append(result, c.cloneBlob(blob))
 		}
 	}
 
@@ -126,19 +106,15 @@ func (c *BlobsMockClientV1) GetBlobsByIds(ctx context.Context, correlationId str
 }
 
 func (c *BlobsMockClientV1) GetBlobById(ctx context.Context, correlationId string, blobId string) (result *BlobInfoV1, err error) {
-	for _, b := range c.blobs {
-		if blobId == b.Id {
-			buf := *b
-			result = &buf
-		}
-	}
+	c.lock.RLock()
+	defer c.lock.RUnlock()
 
-	return result, nil
+	return c.cloneBlob(c.blobs[blobId]), nil
 }
 
 func (c *BlobsMockClientV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	uri string) (result *BlobInfoV1, err error) {
-	return BlobsUriProcessorV1.CreateBlobFromUri(ctx, correlationId, blob, c, uri, int(c.chunkSize))
+	return BlobsUriProcessorV1.CreateBlobFromUriWithOptions(ctx, correlationId, blob, c, uri, c.options)
 }
 
 func (c *BlobsMockClientV1) GetBlobUriById(ctx context.Context, correlationId string, blobId string) (result string, err error) {
@@ -146,39 +122,68 @@ func (c *BlobsMockClientV1) GetBlobUriById(ctx context.Context, correlationId st
 }
 
 func (c *BlobsMockClientV1) CreateBlobFromData(ctx context.Context, correlationId string, blob *BlobInfoV1, buffer []byte) (result *BlobInfoV1, err error) {
-	return BlobsDataProcessorV1.CreateBlobFromData(ctx, correlationId, blob, c, buffer, int(c.chunkSize))
+	return BlobsDataProcessorV1.CreateBlobFromDataWithOptions(ctx, correlationId, blob, c, buffer, c.options)
 }
 
 func (c *BlobsMockClientV1) GetBlobDataById(ctx context.Context, correlationId string, blobId string) (result []byte, blob *BlobInfoV1, err error) {
-	return BlobsDataProcessorV1.GetBlobDataById(ctx, correlationId, blobId, c, int(c.chunkSize))
+	return BlobsDataProcessorV1.GetBlobDataByIdWithOptions(ctx, correlationId, blobId, c, c.options)
 }
 
 func (c *BlobsMockClientV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1, stream io.Reader) (result *BlobInfoV1, err error) {
-	return BlobsStreamProcessorV1.CreateBlobFromStream(ctx, correlationId, blob, c, stream, int(c.chunkSize))
+	return BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, c, stream, c.options)
+}
+
+func (c *BlobsMockClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1,
+	stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.CreateBlobFromStreamResumable(ctx, correlationId, key, blob, c, stream, c.options)
 }
 
 func (c *BlobsMockClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string, stream io.Writer) (blob *BlobInfoV1, err error) {
-	return BlobsStreamProcessorV1.GetBlobStreamById(ctx, correlationId, blobId, c, stream, int(c.chunkSize))
+	return BlobsStreamProcessorV1.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, c, stream, c.options)
+}
+
+func (c *BlobsMockClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	return BlobsDataProcessorV1.GetBlobRangeByIdWithOptions(ctx, correlationId, blobId, offset, length, c, c.options)
+}
+
+func (c *BlobsMockClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return BlobsStreamProcessorV1.GetBlobRangeStreamByIdWithOptions(ctx, correlationId, blobId, offset, length,
+		c, stream, c.options)
+}
+
+func (c *BlobsMockClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, c.options)
+}
+
+func (c *BlobsMockClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobWriter(ctx, correlationId, blob, c, c.options)
+}
+
+func (c *BlobsMockClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, c.options)
 }
 
 func (c *BlobsMockClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
-	for i, b := range c.blobs {
-		if blob.Id == b.Id {
-			buf := *blob
-			c.blobs[i] = &buf
-			return blob, nil
-		}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if _, ok := c.blobs[blob.Id]; !ok {
+		return nil, nil
 	}
 
-	return nil, nil
+	c.blobs[blob.Id] = c.cloneBlob(blob)
+	return c.cloneBlob(blob), nil
 }
 
 func (c *BlobsMockClientV1) MarkBlobsCompleted(ctx context.Context, correlationId string, blobIds []string) error {
-	for _, b := range c.blobs {
-		for _, id := range blobIds {
-			if b.Id == id {
-				b.Completed = true
-			}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for _, id := range blobIds {
+		if blob, ok := c.blobs[id]; ok {
+			blob.Completed = true
 		}
 	}
 
@@ -186,23 +191,38 @@ func (c *BlobsMockClientV1) MarkBlobsCompleted(ctx context.Context, correlationI
 }
 
 func (c *BlobsMockClientV1) DeleteBlobById(ctx context.Context, correlationId string, blobId string) error {
-	for i, b := range c.blobs {
-		if blobId == b.Id {
-			c.blobs = append(c.blobs[:i], c.blobs[i+1:]...)
-			break
+	return c.DeleteBlobsByIds(ctx, correlationId, []string{blobId})
+}
+
+func (c *BlobsMockClientV1) DeleteBlobsByIds(ctx context.Context, correlationId string, blobIds []string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	deleted := make(map[string]bool, len(blobIds))
+	for _, id := range blobIds {
+		if _, ok := c.blobs[id]; ok {
+			delete(c.blobs, id)
+			delete(c.content, id)
+			deleted[id] = true
 		}
 	}
+	if len(deleted) == 0 {
+		return nil
+	}
 
-	return nil
-}
+	// Keep the rest in creation order
+	ids := make([]string, 0, len(c.ids))
+	for _, id := range c.ids {
+		if !deleted[id] {
+			ids = append(ids, id)
+		}
+	}
+	c.ids = ids
 
-func (c *BlobsMockClientV1) DeleteBlobsByIds(ctx context.Context, correlationId string, blobIds []string) error {
-	for i, b := range c.blobs {
-		for _, id := range blobIds {
-			if b.Id == id {
-				c.blobs = append(c.blobs[:i], c.blobs[i+1:]...)
-				break
-			}
+	// Drop unfinished writes of deleted blobs
+	for token, write := range c.writes {
+		if deleted[write.blobId] {
+			delete(c.writes, token)
 		}
 	}
 
@@ -235,6 +255,22 @@ func (c *BlobsMockClientV1) fixBlob(blob *BlobInfoV1) *BlobInfoV1 {
 	return blob
 }
 
+func (c *BlobsMockClientV1) newTooLargeError(correlationId string, blobId string, size int64) error {
+	return errors.NewBadRequestError(correlationId, "BLOB_TOO_LARGE",
+		"Blob "+blobId+" exceeds allowed maximum size of "+strconv.FormatInt(c.maxBlobSize, 10),
+	).WithDetails("blob_id", blobId).WithDetails("size", size).WithDetails("max_size", c.maxBlobSize)
+}
+
+// getWrite returns the unfinished write by its token, it must be called under the lock
+func (c *BlobsMockClientV1) getWrite(correlationId string, token string) (*blobsMockWrite, error) {
+	write, ok := c.writes[token]
+	if !ok {
+		return nil, errors.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob write "+token+" was not found").WithDetails("token", token)
+	}
+	return write, nil
+}
+
 func (c *BlobsMockClientV1) BeginBlobWrite(ctx context.Context, correlationId string, blob *BlobInfoV1) (token string, err error) {
 	if blob.Id == "" {
 		blob.Id = data.IdGenerator.NextLong()
@@ -242,125 +278,202 @@ func (c *BlobsMockClientV1) BeginBlobWrite(ctx context.Context, correlationId st
 
 	blob = c.fixBlob(blob)
 	if blob.Size > 0 && blob.Size > c.maxBlobSize {
-		return "", errors.NewBadRequestError(correlationId, "BLOB_TOO_LARGE",
-			"Blob "+blob.Id+" exceeds allowed maximum size of "+strconv.FormatInt(c.maxBlobSize, 10),
-		).WithDetails("blob_id", blob.Id).WithDetails("size", blob.Size).WithDetails("max_size", c.maxBlobSize)
+		return "", c.newTooLargeError(correlationId, blob.Id, blob.Size)
 	}
 
-	c.blobs = append(c.blobs, blob)
+	c.lock.Lock()
+	defer c.lock.Unlock()
 
-	c.content[blob.Id] = make([]byte, 0)
-	return blob.Id, nil
+	if _, ok := c.blobs[blob.Id]; !ok {
+		c.ids = append(c.ids, blob.Id)
+	}
+	c.blobs[blob.Id] = c.cloneBlob(blob)
+	delete(c.content, blob.Id)
 
+	token = data.IdGenerator.NextLong()
+	c.writes[token] = &blobsMockWrite{blobId: blob.Id, buffer: make([]byte, 0)}
+	return token, nil
 }
 
-func (c *BlobsMockClientV1) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (token2 string, err error) {
-	if chunk == nil {
-		chunk = make([]byte, 0)
-	}
-
-	id := token
-	oldBuffer, ok := c.content[id]
-	if !ok {
-		return "", errors.NewNotFoundError(correlationId,
-			"BLOB_NOT_FOUND",
-			"Blob "+id+" was not found",
-		).WithDetails("blob_id", id)
+// writeAt puts the chunk into the write buffer at offset, it must be called under the lock
+func (c *BlobsMockClientV1) writeAt(correlationId string, write *blobsMockWrite, offset int64, chunk []byte) error {
+	if offset < 0 {
+		return errors.NewBadRequestError(correlationId, "INVALID_OFFSET",
+			"Offset "+strconv.FormatInt(offset, 10)+" is not valid").
+			WithDetails("blob_id", write.blobId).WithDetails("offset", offset)
 	}
 
 	// Enforce maximum size
-	chunkLength := 0
-	if chunk != nil {
-		chunkLength = len(chunk)
+	end := offset + int64(len(chunk))
+	if c.maxBlobSize > 0 && end > c.maxBlobSize {
+		return c.newTooLargeError(correlationId, write.blobId, end)
 	}
 
-	if c.maxBlobSize > 0 && len(oldBuffer)+chunkLength > int(c.maxBlobSize) {
-		return "", errors.NewBadRequestError(
-			correlationId,
-			"BLOB_TOO_LARGE",
-			"Blob "+id+" exceeds allowed maximum size of "+strconv.FormatInt(c.maxBlobSize, 10),
-		).WithDetails("blob_id", id).WithDetails("size", len(oldBuffer)+chunkLength).WithDetails("max_size", c.maxBlobSize)
+	// Parts may come in any order, so grow the buffer up to the part end
+	if end > int64(len(write.buffer)) {
+		buffer := make([]byte, end)
+		copy(buffer, write.buffer)
+		write.buffer = buffer
 	}
+	copy(write.buffer[offset:], chunk)
+
+	write.ranges.add(offset, end)
+	return nil
+}
 
-	buffer := make([]byte, 0, len(chunk))
-	if len(chunk) > 0 {
-		buffer = chunk
+func (c *BlobsMockClientV1) WriteBlobChunk(ctx context.Context, correlationId string, token string, chunk []byte) (token2 string, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	write, err := c.getWrite(correlationId, token)
+	if err != nil {
+		return "", err
 	}
-	c.content[id] = append(oldBuffer, buffer...)
 
+	err = c.writeAt(correlationId, write, int64(len(write.buffer)), chunk)
+	if err != nil {
+		return "", err
+	}
 	return token, nil
+}
 
+func (c *BlobsMockClientV1) SupportsBlobParts(ctx context.Context, correlationId string) bool {
+	return true
 }
 
-func (c *BlobsMockClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string, chunk []byte) (blob *BlobInfoV1, err error) {
-	if chunk == nil {
-		chunk = make([]byte, 0)
+func (c *BlobsMockClientV1) WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	write, err := c.getWrite(correlationId, token)
+	if err != nil {
+		return err
 	}
 
-	id := token
+	return c.writeAt(correlationId, write, offset, chunk)
+}
 
-	// Write last chunk of the blob
-	_, err = c.WriteBlobChunk(ctx, correlationId, token, chunk)
+func (c *BlobsMockClientV1) EndBlobWrite(ctx context.Context, correlationId string, token string, chunk []byte) (blob *BlobInfoV1, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	write, err := c.getWrite(correlationId, token)
 	if err != nil {
-		return blob, err
+		return nil, err
 	}
 
-	blob, err = c.GetBlobById(ctx, correlationId, id)
+	// Content with missing parts is not completed, the gaps can still be written
+	if write.ranges.Committed < int64(len(write.buffer)) {
+		return nil, errors.NewBadRequestError(correlationId, "BLOB_INCOMPLETE",
+			"Blob "+write.blobId+" is missing content after offset "+strconv.FormatInt(write.ranges.Committed, 10)).
+			WithDetails("blob_id", write.blobId).WithDetails("offset", write.ranges.Committed)
+	}
+
+	// Write last chunk of the blob
+	err = c.writeAt(correlationId, write, int64(len(write.buffer)), chunk)
 	if err != nil {
-		return blob, err
+		return nil, err
 	}
+	delete(c.writes, token)
 
-	if blob == nil {
-		return blob, errors.NewNotFoundError(correlationId,
+	blob, ok := c.blobs[write.blobId]
+	if !ok {
+		return nil, errors.NewNotFoundError(correlationId,
 			"BLOB_NOT_FOUND",
-			"Blob "+id+" was not found",
-		).WithDetails("blob_id", id)
+			"Blob "+write.blobId+" was not found",
+		).WithDetails("blob_id", write.blobId)
+	}
+
+	// Keep content digest and verify the one sent by the client
+	checksum := ComputeBlobChecksumV1(blob.ChecksumAlgorithm, write.buffer)
+	if checksum != "" && blob.Checksum != "" && !strings.EqualFold(blob.Checksum, checksum) {
+		c.removeBlob(write.blobId)
+		return nil, NewBlobsIntegrityErrorV1(correlationId, write.blobId, blob.ChecksumAlgorithm, blob.Checksum, checksum)
 	}
 
-	// Update blob info with size and create time
-	buffer, bufOk := c.content[id]
+	// Update blob info with size, create time and digest
+	blob.Size = int64(len(write.buffer))
 	blob.CreateTime = time.Now()
-	if bufOk {
-		blob.Size = int64(len(buffer))
+	if checksum != "" {
+		blob.Checksum = checksum
+	}
+	c.content[write.blobId] = write.buffer
+
+	return c.cloneBlob(blob), nil
+}
+
+// removeBlob deletes the blob, it must be called under the lock
+func (c *BlobsMockClientV1) removeBlob(blobId string) {
+	delete(c.blobs, blobId)
+	delete(c.content, blobId)
+	for i, id := range c.ids {
+		if id == blobId {
+			c.ids = append(c.ids[:i:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+func (c *BlobsMockClientV1) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (offset int64, token2 string, err error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	write, err := c.getWrite(correlationId, token)
+	if err != nil {
+		return 0, "", err
 	}
 
-	return c.UpdateBlobInfo(ctx, correlationId, blob)
+	return write.ranges.Committed, token, nil
 }
 
 func (c *BlobsMockClientV1) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
-	id := token
-	return c.DeleteBlobById(ctx, correlationId, id)
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	write, ok := c.writes[token]
+	if !ok {
+		return nil
+	}
+
+	delete(c.writes, token)
+	c.removeBlob(write.blobId)
+	return nil
 }
 
 func (c *BlobsMockClientV1) BeginBlobRead(ctx context.Context, correlationId string, blobId string) (blob *BlobInfoV1, err error) {
-	oldBuffer, bufOk := c.content[blobId]
-	if !bufOk || len(oldBuffer) == 0 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if _, ok := c.content[blobId]; !ok {
 		return nil, errors.NewNotFoundError(correlationId,
 			"BLOB_NOT_FOUND",
 			"Blob "+blobId+" was not found",
 		).WithDetails("blob_id", blobId)
 	}
 
-	return c.GetBlobById(ctx, correlationId, blobId)
+	return c.cloneBlob(c.blobs[blobId]), nil
 }
 
 func (c *BlobsMockClientV1) ReadBlobChunk(ctx context.Context, correlationId string, blobId string, skip int64, take int64) (chunk []byte, err error) {
-	oldBuffer, bufOk := c.content[blobId]
-	if !bufOk || len(oldBuffer) == 0 {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	buffer, ok := c.content[blobId]
+	if !ok {
 		return nil, errors.NewNotFoundError(correlationId,
 			"BLOB_NOT_FOUND",
 			"Blob "+blobId+" was not found",
 		).WithDetails("blob_id", blobId)
 	}
 
-	if int(skip) > len(oldBuffer) {
+	// Content is never modified after the write ends, so it can be shared
+	if skip < 0 || skip > int64(len(buffer)) {
 		return make([]byte, 0), nil
-	} else if int(skip+take) > len(oldBuffer) {
-		return oldBuffer[skip:], nil
+	} else if take < 0 || skip+take > int64(len(buffer)) {
+		return buffer[skip:], nil
 	} else {
-		return oldBuffer[skip : skip+take], nil
+		return buffer[skip : skip+take], nil
 	}
-
 }
 
 func (c *BlobsMockClientV1) EndBlobRead(ctx context.Context, correlationId string, blobId string) error {
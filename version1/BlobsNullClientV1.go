@@ -18,6 +18,10 @@ func (c *BlobsNullClientV1) GetBlobsByFilter(ctx context.Context, correlationId
 	return *data.NewEmptyDataPage[*BlobInfoV1](), nil
 }
 
+func (c *BlobsNullClientV1) GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams, paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error) {
+	return *data.NewEmptyDataPage[*BlobInfoV1](), nil
+}
+
 func (c *BlobsNullClientV1) GetBlobsByIds(ctx context.Context, correlationId string, blobIds []string) (result []*BlobInfoV1, err error) {
 	return nil, nil
 }
@@ -46,10 +50,38 @@ func (c *BlobsNullClientV1) CreateBlobFromStream(ctx context.Context, correlatio
 	return nil, nil
 }
 
+func (c *BlobsNullClientV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string, blob *BlobInfoV1, stream io.ReadSeeker) (result *BlobInfoV1, err error) {
+	return nil, nil
+}
+
 func (c *BlobsNullClientV1) ReadBlobStreamById(ctx context.Context, correlationId string, blobId string, stream io.Writer) (blob *BlobInfoV1, err error) {
 	return nil, nil
 }
 
+func (c *BlobsNullClientV1) GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64) (result []byte, blob *BlobInfoV1, err error) {
+	return nil, nil, nil
+}
+
+func (c *BlobsNullClientV1) ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+	offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error) {
+	return nil, nil
+}
+
+// OpenBlobReader fails with BLOB_NOT_FOUND, as the null client has no blobs
+func (c *BlobsNullClientV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string) (*BlobsReaderV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobReader(ctx, correlationId, blobId, c, nil)
+}
+
+// OpenBlobWriter returns a writer that discards the content
+func (c *BlobsNullClientV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1) (*BlobsWriterV1, error) {
+	return BlobsStreamProcessorV1.OpenBlobWriter(ctx, correlationId, blob, c, nil)
+}
+
+func (c *BlobsNullClientV1) ListBlobs(ctx context.Context, correlationId string, filter *data.FilterParams) *BlobsIteratorV1 {
+	return NewBlobsIteratorV1(ctx, correlationId, c, filter, nil)
+}
+
 func (c *BlobsNullClientV1) UpdateBlobInfo(ctx context.Context, correlationId string, blob *BlobInfoV1) (result *BlobInfoV1, err error) {
 	return blob, nil
 }
@@ -90,6 +122,10 @@ func (c *BlobsNullClientV1) EndBlobWrite(ctx context.Context, correlationId stri
 	return blob, nil
 }
 
+func (c *BlobsNullClientV1) GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (offset int64, token2 string, err error) {
+	return 0, token, nil
+}
+
 func (c *BlobsNullClientV1) AbortBlobWrite(ctx context.Context, correlationId string, token string) error {
 	return nil
 }
@@ -0,0 +1,113 @@
+package version1
+
+import (
+	"context"
+	"sync"
+)
+
+// blobsPartUploader sends offset-tagged parts through IBlobsChunkyPartWriterV1
+// with at most concurrency parts in flight. Part buffers are recycled
+// so memory stays bounded by concurrency * chunkSize.
+type blobsPartUploader struct {
+	ctx           context.Context
+	cancel        context.CancelFunc
+	correlationId string
+	writer        IBlobsChunkyPartWriterV1
+	retries       *BlobsRetryPolicyV1
+	limiter       *BlobsRateLimiterV1
+	token         string
+	progress      *blobsProgress
+	slots         chan []byte
+	wg            sync.WaitGroup
+	lock          sync.Mutex
+	err           error
+}
+
+func newBlobsPartUploader(ctx context.Context, correlationId string, writer IBlobsChunkyPartWriterV1,
+	token string, progress *blobsProgress, options *BlobsTransferOptionsV1) *blobsPartUploader {
+
+	concurrency := options.getUploadConcurrency()
+	ctx, cancel := context.WithCancel(ctx)
+	c := &blobsPartUploader{
+		ctx:           ctx,
+		cancel:        cancel,
+		correlationId: correlationId,
+		writer:        writer,
+		retries:       options.getRetries(),
+		limiter:       options.getUploadLimiter(),
+		token:         token,
+		progress:      progress,
+		slots:         make(chan []byte, concurrency),
+	}
+
+	// Buffers are allocated lazily by the caller
+	for i := 0; i < concurrency; i++ {
+		c.slots <- nil
+	}
+
+	return c
+}
+
+// acquire waits for a free slot and returns its buffer (nil if not allocated yet)
+func (c *blobsPartUploader) acquire() ([]byte, error) {
+	select {
+	case buffer := <-c.slots:
+		if err := c.failure(); err != nil {
+			c.release(buffer)
+			return nil, err
+		}
+		return buffer, nil
+	case <-c.ctx.Done():
+		if err := c.failure(); err != nil {
+			return nil, err
+		}
+		return nil, c.ctx.Err()
+	}
+}
+
+// release returns an unused slot
+func (c *blobsPartUploader) release(buffer []byte) {
+	c.slots <- buffer
+}
+
+// upload sends chunk at offset in the background and releases buffer when done
+func (c *blobsPartUploader) upload(offset int64, chunk []byte, buffer []byte) {
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.release(buffer)
+
+		err := c.limiter.Wait(c.ctx, len(chunk))
+		if err == nil {
+			err = c.retries.writePart(c.ctx, c.correlationId, c.writer, c.token, offset, chunk)
+		}
+		if err != nil {
+			c.fail(err)
+			return
+		}
+		c.progress.add(len(chunk))
+	}()
+}
+
+func (c *blobsPartUploader) fail(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err == nil {
+		c.err = err
+		c.cancel()
+	}
+}
+
+func (c *blobsPartUploader) failure() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err
+}
+
+// wait blocks until all parts are sent and returns the first error
+func (c *blobsPartUploader) wait() error {
+	c.wg.Wait()
+	c.cancel()
+	return c.failure()
+}
@@ -0,0 +1,159 @@
+package version1
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	BlobsTransferUpload   = "upload"
+	BlobsTransferDownload = "download"
+)
+
+// BlobsProgressV1 describes the state of a blob transfer.
+// Bytes are counted as they are sent or received, so compressed blobs report their stored size.
+type BlobsProgressV1 struct {
+	BlobId     string
+	Operation  string
+	Bytes      int64
+	Total      int64 // -1 when size is not known
+	Chunk      int   // number of transferred chunks
+	Elapsed    time.Duration
+	Throughput float64       // bytes per second
+	Eta        time.Duration // -1 when size is not known
+	Done       bool
+}
+
+// IBlobsProgressObserverV1 receives progress of blob transfers.
+// It is called after every chunk and once more when the transfer is done,
+// calls of a single transfer never overlap.
+type IBlobsProgressObserverV1 interface {
+	OnBlobProgress(ctx context.Context, correlationId string, progress BlobsProgressV1)
+}
+
+// BlobsProgressFuncV1 adapts a function to IBlobsProgressObserverV1
+type BlobsProgressFuncV1 func(ctx context.Context, correlationId string, progress BlobsProgressV1)
+
+func (c BlobsProgressFuncV1) OnBlobProgress(ctx context.Context, correlationId string, progress BlobsProgressV1) {
+	c(ctx, correlationId, progress)
+}
+
+type blobsProgressKey struct{}
+
+// WithBlobsProgressObserverV1 attaches the observer to transfers started with the context.
+// It takes precedence over the observer set in the transfer options.
+func WithBlobsProgressObserverV1(ctx context.Context, observer IBlobsProgressObserverV1) context.Context {
+	return context.WithValue(ctx, blobsProgressKey{}, observer)
+}
+
+// blobsProgress tracks a single transfer, nil means nobody observes it
+type blobsProgress struct {
+	ctx           context.Context
+	correlationId string
+	observer      IBlobsProgressObserverV1
+	start         time.Time
+	progress      BlobsProgressV1
+	lock          sync.Mutex
+}
+
+func newBlobsProgress(ctx context.Context, correlationId string, operation string, blobId string,
+	total int64, options *BlobsTransferOptionsV1) *blobsProgress {
+
+	observer, _ := ctx.Value(blobsProgressKey{}).(IBlobsProgressObserverV1)
+	if observer == nil && options != nil {
+		observer = options.Progress
+	}
+	if observer == nil {
+		return nil
+	}
+
+	if total < 0 {
+		total = -1
+	}
+	return &blobsProgress{
+		ctx:           ctx,
+		correlationId: correlationId,
+		observer:      observer,
+		start:         time.Now(),
+		progress: BlobsProgressV1{
+			BlobId:    blobId,
+			Operation: operation,
+			Total:     total,
+			Eta:       -1,
+		},
+	}
+}
+
+// setTotal updates the size when it becomes known
+func (c *blobsProgress) setTotal(total int64) {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.progress.Total = total
+}
+
+// add reports a transferred chunk
+func (c *blobsProgress) add(size int) {
+	if c == nil || size == 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.progress.Bytes += int64(size)
+	c.progress.Chunk++
+	c.notify()
+}
+
+// done reports the end of the transfer
+func (c *blobsProgress) done() {
+	if c == nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.progress.Done {
+		return
+	}
+	c.progress.Done = true
+	c.progress.Total = c.progress.Bytes
+	c.notify()
+}
+
+// complete reports the end of the transfer when it succeeded and passes its result through
+func (c *blobsProgress) complete(blob *BlobInfoV1, err error) (*BlobInfoV1, error) {
+	if err != nil {
+		return nil, err
+	}
+	if c != nil && blob != nil {
+		// Id of a new blob may be assigned by the service
+		c.lock.Lock()
+		c.progress.BlobId = blob.Id
+		c.lock.Unlock()
+	}
+	c.done()
+	return blob, nil
+}
+
+// notify calculates speed and sends the progress, it must be called under the lock
+func (c *blobsProgress) notify() {
+	c.progress.Elapsed = time.Since(c.start)
+	if seconds := c.progress.Elapsed.Seconds(); seconds > 0 {
+		c.progress.Throughput = float64(c.progress.Bytes) / seconds
+	}
+
+	c.progress.Eta = -1
+	if c.progress.Total >= 0 && c.progress.Throughput > 0 {
+		left := c.progress.Total - c.progress.Bytes
+		if left < 0 {
+			left = 0
+		}
+		c.progress.Eta = time.Duration(float64(left) / c.progress.Throughput * float64(time.Second))
+	}
+
+	c.observer.OnBlobProgress(c.ctx, c.correlationId, c.progress)
+}
@@ -0,0 +1,111 @@
+package version1
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// BlobsRateLimiterV1 paces blob transfers with a token bucket of bytes.
+// The bucket holds up to one second of transfer, so short bursts are allowed
+// while the average rate stays under the limit. A single limiter can be shared
+// by several clients to keep their total rate under the limit.
+type BlobsRateLimiterV1 struct {
+	lock    sync.Mutex
+	rate    int64
+	tokens  float64
+	updated time.Time
+}
+
+// NewBlobsRateLimiterV1 creates a limiter for rate bytes per second, 0 means unlimited
+func NewBlobsRateLimiterV1(rate int64) *BlobsRateLimiterV1 {
+	return &BlobsRateLimiterV1{
+		rate:    rate,
+		tokens:  float64(rate),
+		updated: time.Now(),
+	}
+}
+
+var blobsSharedRateLimiters = map[string]*BlobsRateLimiterV1{}
+var blobsSharedRateLimitersLock sync.Mutex
+
+// GetSharedBlobsRateLimiterV1 returns the limiter shared in the process under the name.
+// The rate of an existing limiter is updated.
+func GetSharedBlobsRateLimiterV1(name string, rate int64) *BlobsRateLimiterV1 {
+	blobsSharedRateLimitersLock.Lock()
+	defer blobsSharedRateLimitersLock.Unlock()
+
+	limiter, ok := blobsSharedRateLimiters[name]
+	if !ok {
+		limiter = NewBlobsRateLimiterV1(rate)
+		blobsSharedRateLimiters[name] = limiter
+	} else {
+		limiter.SetRate(rate)
+	}
+	return limiter
+}
+
+// Rate returns the limit in bytes per second
+func (c *BlobsRateLimiterV1) Rate() int64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.rate
+}
+
+// SetRate changes the limit in bytes per second, 0 means unlimited
+func (c *BlobsRateLimiterV1) SetRate(rate int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.refill()
+	c.rate = rate
+	if c.tokens > float64(rate) {
+		c.tokens = float64(rate)
+	}
+}
+
+// Wait blocks until size bytes may be transferred or the context is cancelled.
+// Chunks larger than the bucket are allowed and paid off by the following ones.
+func (c *BlobsRateLimiterV1) Wait(ctx context.Context, size int) error {
+	if c == nil || size <= 0 {
+		return nil
+	}
+
+	c.lock.Lock()
+	if c.rate <= 0 {
+		c.lock.Unlock()
+		return nil
+	}
+	c.refill()
+	c.tokens -= float64(size)
+	delay := time.Duration(-c.tokens / float64(c.rate) * float64(time.Second))
+	c.lock.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Bytes were not transferred, so they are returned to the bucket
+		c.lock.Lock()
+		c.tokens += float64(size)
+		c.lock.Unlock()
+		return ctx.Err()
+	}
+}
+
+// refill adds tokens for the time passed since the last update, it must be called under the lock
+func (c *BlobsRateLimiterV1) refill() {
+	now := time.Now()
+	c.tokens += now.Sub(c.updated).Seconds() * float64(c.rate)
+	if c.tokens > float64(c.rate) {
+		c.tokens = float64(c.rate)
+	}
+	c.updated = now
+}
@@ -0,0 +1,211 @@
+package version1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync"
+)
+
+// BlobsReaderV1 gives random access to a remote blob through IBlobsChunkyReaderV1.
+// It implements io.ReadSeekCloser and io.ReaderAt, so blobs can be passed
+// to zip.NewReader, image decoders or http.ServeContent without buffering.
+// Recently used chunks are kept in a small cache. The content checksum is not verified.
+// Compressed blobs are read as stored and only when raw content is requested.
+type BlobsReaderV1 struct {
+	ctx           context.Context
+	correlationId string
+	reader        IBlobsChunkyReaderV1
+	retries       *BlobsRetryPolicyV1
+	limiter       *BlobsRateLimiterV1
+	blob          *BlobInfoV1
+	chunkSize     int64
+	cacheSize     int
+	lock          sync.Mutex
+	cache         map[int64][]byte
+	recent        []int64
+	offset        int64
+	closed        bool
+}
+
+func newBlobsReader(ctx context.Context, correlationId string, reader IBlobsChunkyReaderV1,
+	blob *BlobInfoV1, options *BlobsTransferOptionsV1) *BlobsReaderV1 {
+
+	cacheSize := options.getReadAhead()
+	if cacheSize < 2 {
+		cacheSize = 2
+	}
+
+	return &BlobsReaderV1{
+		ctx:           ctx,
+		correlationId: correlationId,
+		reader:        reader,
+		retries:       options.getRetries(),
+		limiter:       options.getDownloadLimiter(),
+		blob:          blob,
+		chunkSize:     int64(options.getChunkSize()),
+		cacheSize:     cacheSize,
+		cache:         make(map[int64][]byte),
+		recent:        make([]int64, 0, cacheSize),
+	}
+}
+
+// Blob returns information about the blob that is read
+func (c *BlobsReaderV1) Blob() *BlobInfoV1 {
+	return c.blob
+}
+
+// Size returns the blob size in bytes
+func (c *BlobsReaderV1) Size() int64 {
+	return c.blob.Size
+}
+
+func (c *BlobsReaderV1) Read(p []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	n, err := c.readAt(p, c.offset)
+	c.offset += int64(n)
+
+	// Partial read is not an error for io.Reader
+	if n > 0 && err == io.EOF {
+		err = nil
+	}
+	return n, err
+}
+
+func (c *BlobsReaderV1) ReadAt(p []byte, offset int64) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.readAt(p, offset)
+}
+
+func (c *BlobsReaderV1) Seek(offset int64, whence int) (int64, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return 0, os.ErrClosed
+	}
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += c.offset
+	case io.SeekEnd:
+		offset += c.blob.Size
+	default:
+		return 0, errors.New("BlobsReaderV1.Seek: invalid whence")
+	}
+
+	if offset < 0 {
+		return 0, errors.New("BlobsReaderV1.Seek: negative position")
+	}
+
+	c.offset = offset
+	return offset, nil
+}
+
+// Close ends reading of the blob and releases cached chunks
+func (c *BlobsReaderV1) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return nil
+	}
+
+	c.closed = true
+	c.cache = nil
+	c.recent = nil
+
+	// The reader context may be already cancelled
+	return endBlobRead(c.correlationId, c.reader, c.blob.Id)
+}
+
+func (c *BlobsReaderV1) readAt(p []byte, offset int64) (int, error) {
+	if c.closed {
+		return 0, os.ErrClosed
+	}
+	if offset < 0 {
+		return 0, errors.New("BlobsReaderV1.ReadAt: negative offset")
+	}
+
+	n := 0
+	for n < len(p) {
+		position := offset + int64(n)
+		if position >= c.blob.Size {
+			return n, io.EOF
+		}
+
+		index := position / c.chunkSize
+		chunk, err := c.getChunk(index)
+		if err != nil {
+			return n, err
+		}
+
+		start := position - index*c.chunkSize
+		if start >= int64(len(chunk)) {
+			// Blob is shorter than its declared size
+			return n, io.ErrUnexpectedEOF
+		}
+		n += copy(p[n:], chunk[start:])
+	}
+
+	return n, nil
+}
+
+// getChunk returns the chunk by its index from cache or reads it from the service
+func (c *BlobsReaderV1) getChunk(index int64) ([]byte, error) {
+	if chunk, ok := c.cache[index]; ok {
+		c.touch(index)
+		return chunk, nil
+	}
+
+	skip := index * c.chunkSize
+	take := c.chunkSize
+	if take > c.blob.Size-skip {
+		take = c.blob.Size - skip
+	}
+
+	if err := c.limiter.Wait(c.ctx, int(take)); err != nil {
+		return nil, err
+	}
+
+	chunk := make([]byte, 0, take)
+	for int64(len(chunk)) < take {
+		buffer, err := c.retries.readChunk(c.ctx, c.correlationId, c.reader, c.blob.Id,
+			skip+int64(len(chunk)), take-int64(len(chunk)), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		// Protection against infinite loop
+		if len(buffer) == 0 {
+			break
+		}
+		chunk = append(chunk, buffer...)
+	}
+
+	// Evict the least recently used chunk
+	if len(c.recent) >= c.cacheSize {
+		delete(c.cache, c.recent[0])
+		c.recent = c.recent[1:]
+	}
+	c.cache[index] = chunk
+	c.recent = append(c.recent, index)
+
+	return chunk, nil
+}
+
+func (c *BlobsReaderV1) touch(index int64) {
+	for i, v := range c.recent {
+		if v == index {
+			c.recent = append(c.recent[:i], c.recent[i+1:]...)
+			break
+		}
+	}
+	c.recent = append(c.recent, index)
+}
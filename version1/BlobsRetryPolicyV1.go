@@ -0,0 +1,203 @@
+package version1
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"time"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobsRetryPolicyV1 defines how failed chunk operations are repeated.
+// Only the failed chunk is resent, the rest of the transfer is not affected.
+//
+//	Configuration parameters:
+//		- options:
+//			- retries:
+//				- attempts: total number of attempts per chunk (default: 1, no retries)
+//				- min_timeout: delay before the first retry in milliseconds (default: 100)
+//				- max_timeout: maximum delay between retries in milliseconds (default: 5000)
+//				- jitter: random deviation of the delay from 0 to 1 (default: 0.2)
+//				- categories: comma-separated error categories to retry (default: NoResponse,FailedInvocation,Unknown)
+type BlobsRetryPolicyV1 struct {
+	Attempts   int
+	MinTimeout time.Duration
+	MaxTimeout time.Duration
+	Jitter     float64
+	Categories []string
+}
+
+func NewBlobsRetryPolicyV1() *BlobsRetryPolicyV1 {
+	return &BlobsRetryPolicyV1{
+		Attempts:   1,
+		MinTimeout: 100 * time.Millisecond,
+		MaxTimeout: 5000 * time.Millisecond,
+		Jitter:     0.2,
+		Categories: []string{cerr.NoResponse, cerr.FailedInvocation, cerr.Unknown},
+	}
+}
+
+func (c *BlobsRetryPolicyV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.Attempts = config.GetAsIntegerWithDefault("options.retries.attempts", c.Attempts)
+	c.MinTimeout = time.Duration(config.GetAsLongWithDefault("options.retries.min_timeout",
+		c.MinTimeout.Milliseconds())) * time.Millisecond
+	c.MaxTimeout = time.Duration(config.GetAsLongWithDefault("options.retries.max_timeout",
+		c.MaxTimeout.Milliseconds())) * time.Millisecond
+	c.Jitter = config.GetAsDoubleWithDefault("options.retries.jitter", c.Jitter)
+
+	categories := config.GetAsString("options.retries.categories")
+	if categories != "" {
+		c.Categories = make([]string, 0)
+		for _, category := range strings.Split(categories, ",") {
+			category = strings.TrimSpace(category)
+			if category != "" {
+				c.Categories = append(c.Categories, category)
+			}
+		}
+	}
+}
+
+// IsRetryable checks if the error belongs to one of the retryable categories
+func (c *BlobsRetryPolicyV1) IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	category := cerr.Unknown
+	var appErr *cerr.ApplicationError
+	if errors.As(err, &appErr) {
+		category = appErr.Category
+	}
+
+	for _, v := range c.Categories {
+		if strings.EqualFold(v, category) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *BlobsRetryPolicyV1) getDelay(attempt int) time.Duration {
+	delay := c.MinTimeout
+	for i := 1; i < attempt && delay < c.MaxTimeout; i++ {
+		delay *= 2
+	}
+	if delay > c.MaxTimeout {
+		delay = c.MaxTimeout
+	}
+
+	if c.Jitter > 0 {
+		delay += time.Duration(float64(delay) * c.Jitter * (2*rand.Float64() - 1))
+	}
+
+	return delay
+}
+
+// retry calls action until it succeeds, fails with a non-retryable error,
+// runs out of attempts or the context is done
+func (c *BlobsRetryPolicyV1) retry(ctx context.Context, action func(attempt int) error) error {
+	attempts := 1
+	if c != nil && c.Attempts > 1 {
+		attempts = c.Attempts
+	}
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(c.getDelay(attempt)):
+			case <-ctx.Done():
+				return err
+			}
+		}
+
+		err = action(attempt)
+		if err == nil || attempt+1 >= attempts || ctx.Err() != nil || !c.IsRetryable(err) {
+			return err
+		}
+	}
+}
+
+// writeChunk sends the chunk, every attempt is reported to the sizer
+func (c *BlobsRetryPolicyV1) writeChunk(ctx context.Context, correlationId string, writer IBlobsChunkyWriterV1,
+	token string, offset int64, chunk []byte, sizer *blobsChunkSizer) (string, error) {
+
+	result := token
+	replyLost := false
+	err := c.retry(ctx, func(attempt int) error {
+		sendToken := token
+		send := chunk
+
+		// A lost reply may hide a write, complete or partial, so ask the server what it has
+		// and continue with the token it issued for that write
+		if resumableWriter, ok := writer.(IBlobsResumableWriterV1); ok && replyLost {
+			committed, committedToken, err := resumableWriter.GetBlobWriteOffset(ctx, correlationId, token)
+			if err == nil && committed > offset {
+				if committedToken == "" {
+					return cerr.NewConflictError(correlationId, "BLOB_TOKEN_LOST",
+						"Chunk was written but the token to continue the upload was not returned").
+						WithDetails("offset", offset)
+				}
+				if committed >= offset+int64(len(chunk)) {
+					result = committedToken
+					return nil
+				}
+				sendToken = committedToken
+				send = chunk[committed-offset:]
+			}
+		}
+
+		start := time.Now()
+		newToken, err := writer.WriteBlobChunk(ctx, correlationId, sendToken, send)
+		sizer.observe(len(send), time.Since(start), err)
+		if err == nil {
+			result = newToken
+		}
+		replyLost = isBlobsReplyLost(err)
+		return err
+	})
+
+	return result, err
+}
+
+// isBlobsReplyLost checks if the write may have reached the server without its reply coming back.
+// Errors answered by the server and cancellations on the client mean nothing was committed.
+func isBlobsReplyLost(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var appErr *cerr.ApplicationError
+	if errors.As(err, &appErr) {
+		return appErr.Category == cerr.NoResponse
+	}
+	return true
+}
+
+func (c *BlobsRetryPolicyV1) writePart(ctx context.Context, correlationId string, writer IBlobsChunkyPartWriterV1,
+	token string, offset int64, chunk []byte) error {
+
+	return c.retry(ctx, func(attempt int) error {
+		return writer.WriteBlobPart(ctx, correlationId, token, offset, chunk)
+	})
+}
+
+// readChunk reads the chunk, every attempt is reported to the sizer
+func (c *BlobsRetryPolicyV1) readChunk(ctx context.Context, correlationId string, reader IBlobsChunkyReaderV1,
+	blobId string, skip int64, take int64, sizer *blobsChunkSizer) ([]byte, error) {
+
+	var result []byte
+	err := c.retry(ctx, func(attempt int) error {
+		var err error
+		start := time.Now()
+		result, err = reader.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+		sizer.observe(int(take), time.Since(start), err)
+		return err
+	})
+
+	return result, err
+}
@@ -2,12 +2,12 @@ package version1
 
 import (
 	"context"
-	"errors"
 	"io"
-	"math"
+	"strconv"
 	"time"
 
 	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
 )
 
 type TBlobsStreamProcessorV1 struct{}
@@ -16,6 +16,15 @@ var BlobsStreamProcessorV1 = &TBlobsStreamProcessorV1{}
 
 func (c *TBlobsStreamProcessorV1) CreateBlobFromStream(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	writer IBlobsChunkyWriterV1, stream io.Reader, chunkSize int) (*BlobInfoV1, error) {
+	return c.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, writer, stream,
+		NewBlobsTransferOptionsV1WithChunkSize(chunkSize))
+}
+
+func (c *TBlobsStreamProcessorV1) CreateBlobFromStreamWithOptions(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	writer IBlobsChunkyWriterV1, stream io.Reader, options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
+	concurrency := options.getUploadConcurrency()
 
 	// Generate blob id
 	if blob.Id == "" {
@@ -23,47 +32,314 @@ func (c *TBlobsStreamProcessorV1) CreateBlobFromStream(ctx context.Context, corr
 	}
 	blob.CreateTime = time.Now()
 
+	// Content type is checked before anything is sent
+	stream, err := prepareBlobStreamContentType(correlationId, blob, stream, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Compress the content on the fly
+	codec, err := prepareBlobEncoding(correlationId, blob, options)
+	if err != nil {
+		return nil, err
+	}
+	if codec != nil {
+		encoded := encodeBlobStream(codec, stream)
+		defer encoded.Close()
+		stream = encoded
+	}
+
+	// Digest is calculated as content goes through
+	checksum := options.newChecksum()
+	checksum.prepare(blob)
+	stream = io.TeeReader(stream, checksum)
+
+	// Size of compressed content is not known in advance
+	total := int64(-1)
+	if codec == nil && blob.Size > 0 {
+		total = blob.Size
+	}
+	progress := newBlobsProgress(ctx, correlationId, BlobsTransferUpload, blob.Id, total, options)
+
 	// Start writing
 	token, err := writer.BeginBlobWrite(ctx, correlationId, blob)
 	if err != nil {
 		return nil, err
 	}
 
+	// Send chunks in parallel when the writer supports offset-tagged parts
+	partWriter, ok := writer.(IBlobsChunkyPartWriterV1)
+	if ok && concurrency > 1 && partWriter.SupportsBlobParts(ctx, correlationId) {
+		blob, err = c.writeStreamInParts(ctx, correlationId, blob.Id, token, writer, partWriter, stream, progress, options)
+		if err != nil {
+			return nil, err
+		}
+		return progress.complete(checksum.complete(ctx, correlationId, writer, blob))
+	}
+
 	// Write in chunks
-	buffer := make([]byte, chunkSize)
+	sizer := newBlobsChunkSizer(options)
+	buffer := make([]byte, sizer.bufferSize(chunkSize))
+	offset := int64(0)
 
 	for {
-		size, err1 := stream.Read(buffer)
+		size, err1 := io.ReadFull(stream, buffer[:sizer.next(chunkSize)])
+		if err1 == io.ErrUnexpectedEOF {
+			err1 = io.EOF
+		}
 
 		if err1 == io.EOF && size == 0 {
 			break
 		}
 		if err1 != nil && err1 != io.EOF {
-			return nil, err1
+			abortBlobWrite(correlationId, writer, token)
+			return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err1)
 		}
 
 		if size == 0 {
 			continue
 		}
 
-		chunk := buffer
+		chunk := buffer[0:size]
+
+		// Stop between chunks when the transfer is cancelled
+		err = ctx.Err()
+		if err == nil {
+			err = options.getUploadLimiter().Wait(ctx, len(chunk))
+		}
+		if err == nil {
+			token, err = options.getRetries().writeChunk(ctx, correlationId, writer, token, offset, chunk, sizer)
+		}
+		if err != nil {
+			abortBlobWrite(correlationId, writer, token)
+			return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err)
+		}
+		offset += int64(size)
+		progress.add(size)
+	}
+
+	// Finish writing and return blobId
+	result, err := writer.EndBlobWrite(ctx, correlationId, token, nil)
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blob.Id, offset, err)
+	}
+
+	return progress.complete(checksum.complete(ctx, correlationId, writer, result))
+}
+
+// CreateBlobFromStreamResumable uploads the stream keeping a checkpoint under the key.
+// When a checkpoint exists and the writer reports the committed offset,
+// the upload continues from that offset instead of starting over.
+// On failure the upload is not aborted, so it can be resumed later.
+// Resumed uploads must produce the same bytes, so content encoding is not supported.
+func (c *TBlobsStreamProcessorV1) CreateBlobFromStreamResumable(ctx context.Context, correlationId string, key string,
+	blob *BlobInfoV1, writer IBlobsChunkyWriterV1, stream io.ReadSeeker, options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
+	store := options.Checkpoints
+	if store == nil {
+		return nil, cerr.NewConfigError(correlationId, "NO_CHECKPOINT_STORE",
+			"Checkpoint store is not set for resumable upload")
+	}
+	if encoding := options.getContentEncoding(); encoding != ContentEncodingNone {
+		return nil, cerr.NewConfigError(correlationId, "UNSUPPORTED_ENCODING",
+			"Content encoding "+encoding+" is not supported by resumable uploads").WithDetails("encoding", encoding)
+	}
+
+	checkpoint, err := store.LoadCheckpoint(ctx, correlationId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := options.newChecksum()
+
+	// Continue from the committed offset
+	if checkpoint != nil {
+		checkpoint.Offset = -1
+		if resumableWriter, ok := writer.(IBlobsResumableWriterV1); ok {
+			offset, token, err := resumableWriter.GetBlobWriteOffset(ctx, correlationId, checkpoint.Token)
+			if err == nil {
+				checkpoint.Offset = offset
+				if token != "" {
+					checkpoint.Token = token
+				}
+			}
+		}
+
+		if checkpoint.Offset < 0 {
+			// The unfinished write cannot be continued, so release it before starting over
+			abortBlobWrite(correlationId, writer, checkpoint.Token)
+			checkpoint = nil
+		} else if checksum == nil {
+			if _, err = stream.Seek(checkpoint.Offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+		} else {
+			// Committed content is read again to restore the digest
+			if _, err = stream.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			if _, err = io.CopyN(checksum, stream, checkpoint.Offset); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Start writing from the beginning
+	if checkpoint == nil {
+		if blob.Id == "" {
+			blob.Id = data.IdGenerator.NextLong()
+		}
+		blob.CreateTime = time.Now()
+		checksum.prepare(blob)
+
+		err = prepareBlobSeekerContentType(correlationId, blob, stream, options)
+		if err != nil {
+			return nil, err
+		}
 
-		if size != len(buffer) {
-			chunk = buffer[0:size]
+		token, err := writer.BeginBlobWrite(ctx, correlationId, blob)
+		if err != nil {
+			return nil, err
 		}
 
-		token, err = writer.WriteBlobChunk(ctx, correlationId, token, chunk)
+		checkpoint = &BlobsUploadCheckpointV1{
+			Key:        key,
+			BlobId:     blob.Id,
+			Token:      token,
+			Offset:     0,
+			UpdateTime: time.Now(),
+		}
+		err = store.SaveCheckpoint(ctx, correlationId, checkpoint)
 		if err != nil {
+			abortBlobWrite(correlationId, writer, token)
 			return nil, err
 		}
 	}
 
+	// Write in chunks and record progress after each one
+	buffer := make([]byte, chunkSize)
+	for {
+		size, err1 := io.ReadFull(stream, buffer)
+		if err1 != nil && err1 != io.EOF && err1 != io.ErrUnexpectedEOF {
+			return nil, err1
+		}
+
+		if size > 0 {
+			checksum.Write(buffer[0:size])
+
+			// Cancelled upload keeps its checkpoint, so it is resumed later
+			err = ctx.Err()
+			if err == nil {
+				err = options.getUploadLimiter().Wait(ctx, size)
+			}
+			if err == nil {
+				checkpoint.Token, err = options.getRetries().writeChunk(ctx, correlationId, writer,
+					checkpoint.Token, checkpoint.Offset, buffer[0:size], nil)
+			}
+			if err != nil {
+				return nil, wrapBlobsContextError(ctx, correlationId, checkpoint.BlobId, checkpoint.Offset, err)
+			}
+
+			checkpoint.Offset += int64(size)
+			checkpoint.UpdateTime = time.Now()
+			err = store.SaveCheckpoint(ctx, correlationId, checkpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err1 != nil {
+			break
+		}
+	}
+
+	// Finish writing and forget the checkpoint
+	blob, err = writer.EndBlobWrite(ctx, correlationId, checkpoint.Token, nil)
+	if err != nil {
+		return nil, wrapBlobsContextError(ctx, correlationId, checkpoint.BlobId, checkpoint.Offset, err)
+	}
+
+	err = store.DeleteCheckpoint(ctx, correlationId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return checksum.complete(ctx, correlationId, writer, blob)
+}
+
+func (c *TBlobsStreamProcessorV1) writeStreamInParts(ctx context.Context, correlationId string, blobId string, token string,
+	writer IBlobsChunkyWriterV1, partWriter IBlobsChunkyPartWriterV1, stream io.Reader, progress *blobsProgress,
+	options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
+	uploader := newBlobsPartUploader(ctx, correlationId, partWriter, token, progress, options)
+	offset := int64(0)
+
+	var err error
+	for {
+		var buffer []byte
+		buffer, err = uploader.acquire()
+		if err != nil {
+			break
+		}
+		if buffer == nil {
+			buffer = make([]byte, chunkSize)
+		}
+
+		size, err1 := io.ReadFull(stream, buffer)
+		if size > 0 {
+			uploader.upload(offset, buffer[0:size], buffer)
+			offset += int64(size)
+		} else {
+			uploader.release(buffer)
+		}
+
+		if err1 == io.EOF || err1 == io.ErrUnexpectedEOF {
+			break
+		}
+		if err1 != nil {
+			err = err1
+			break
+		}
+	}
+
+	err1 := uploader.wait()
+	if err == nil {
+		err = err1
+	}
+	if err == nil {
+		err = ctx.Err()
+	}
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blobId, offset, err)
+	}
+
 	// Finish writing and return blobId
-	return writer.EndBlobWrite(ctx, correlationId, token, nil)
+	blob, err := writer.EndBlobWrite(ctx, correlationId, token, nil)
+	if err != nil {
+		abortBlobWrite(correlationId, writer, token)
+		return nil, wrapBlobsContextError(ctx, correlationId, blobId, offset, err)
+	}
+
+	return blob, nil
 }
 
 func (c *TBlobsStreamProcessorV1) GetBlobStreamById(ctx context.Context, correlationId string,
 	blobId string, reader IBlobsChunkyReaderV1, stream io.Writer, chunkSize int) (*BlobInfoV1, error) {
+	return c.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, reader, stream,
+		NewBlobsTransferOptionsV1WithChunkSize(chunkSize))
+}
+
+func (c *TBlobsStreamProcessorV1) GetBlobStreamByIdWithOptions(ctx context.Context, correlationId string,
+	blobId string, reader IBlobsChunkyReaderV1, stream io.Writer, options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := options.getChunkSize()
 
 	// Begin blob read
 	blob, err := reader.BeginBlobRead(ctx, correlationId, blobId)
@@ -71,41 +347,309 @@ func (c *TBlobsStreamProcessorV1) GetBlobStreamById(ctx context.Context, correla
 		return nil, err
 	}
 
-	if blob == nil || blob.Size == 0 {
-		return nil, errors.New("BLOB WITH ZERO SIZE")
+	// Release the read on the server when the transfer fails
+	ended := false
+	defer func() {
+		if !ended {
+			endBlobRead(correlationId, reader, blobId)
+		}
+	}()
+
+	if blob == nil {
+		return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
 	}
 
 	size := blob.Size
 
-	// Read in chunks
+	// Decompress the content as it comes
+	codec, err := getBlobDecoding(correlationId, blob, options)
+	if err != nil {
+		return nil, err
+	}
+	var decoder *blobsDecodingWriter
+	if codec != nil {
+		decoder = newBlobsDecodingWriter(codec, stream)
+		defer decoder.CloseWithError(io.ErrUnexpectedEOF)
+		stream = decoder
+	}
+
+	// Digest is verified after the whole content was passed to the stream
+	checksum := newBlobsChecksumForRead(blob)
+	if checksum != nil {
+		stream = io.MultiWriter(stream, checksum)
+	}
+
+	progress := newBlobsProgress(ctx, correlationId, BlobsTransferDownload, blobId, size, options)
+
+	// Download chunks ahead of the writer
+	readAhead := options.getReadAhead()
+	if readAhead > 1 && size > int64(chunkSize) {
+		read, err := c.readStreamAhead(ctx, correlationId, blobId, size, reader, stream, progress, options)
+		if err != nil {
+			return nil, err
+		}
+		if read < size {
+			return nil, NewBlobsTruncatedErrorV1(correlationId, blobId, size, read)
+		}
+
+		// Close blob read
+		ended = true
+		err = reader.EndBlobRead(ctx, correlationId, blobId)
+		if err != nil {
+			return nil, err
+		}
+		return progress.complete(c.completeBlobStream(correlationId, blob, checksum, decoder))
+	}
+
+	// Read in chunks until size bytes were passed to the stream.
+	// Short chunks are followed by requests for the rest, empty chunk means the content ended.
+	sizer := newBlobsChunkSizer(options)
 	skip := int64(0)
-	take := int64(math.Min(float64(chunkSize), float64(size)))
-	for {
-		buffer, err1 := reader.ReadBlobChunk(ctx, correlationId, blobId, skip, take)
+	for skip < size {
+		take := int64(sizer.next(chunkSize))
+		if take > size-skip {
+			take = size - skip
+		}
+
+		// Stop between chunks when the transfer is cancelled
+		err1 := ctx.Err()
+		if err1 == nil {
+			err1 = options.getDownloadLimiter().Wait(ctx, int(take))
+		}
+		var buffer []byte
+		if err1 == nil {
+			buffer, err1 = options.getRetries().readChunk(ctx, correlationId, reader, blobId, skip, take, sizer)
+		}
 		if err1 != nil {
-			return nil, err1
+			return nil, wrapBlobsContextError(ctx, correlationId, blobId, skip, err1)
 		}
 
-		// Protection against infinite loop
-		if len(buffer) > 0 {
-			n, err2 := stream.Write(buffer)
-			if err2 != nil {
-				return nil, err
-			}
+		if len(buffer) == 0 {
+			break
+		}
+		// Bytes beyond the requested range are read again with the following chunk
+		if int64(len(buffer)) > take {
+			buffer = buffer[:take]
+		}
 
-			size -= int64(n)
-			skip += int64(n)
-			take = int64(math.Min(float64(chunkSize), float64(size)))
+		n, err2 := stream.Write(buffer)
+		if err2 == nil && n < len(buffer) {
+			err2 = io.ErrShortWrite
+		}
+		if err2 != nil {
+			return nil, err2
+		}
 
-			if len(buffer) < chunkSize {
-				break
-			}
-		} else {
+		skip += int64(n)
+		progress.add(n)
+	}
+
+	// Content shorter than the blob size must not pass for the whole blob
+	if skip < size {
+		return nil, NewBlobsTruncatedErrorV1(correlationId, blobId, size, skip)
+	}
+
+	// Close blob read
+	ended = true
+	err = reader.EndBlobRead(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return progress.complete(c.completeBlobStream(correlationId, blob, checksum, decoder))
+}
+
+// completeBlobStream verifies the content that was read and waits until it is decompressed
+func (c *TBlobsStreamProcessorV1) completeBlobStream(correlationId string, blob *BlobInfoV1,
+	checksum *blobsChecksum, decoder *blobsDecodingWriter) (*BlobInfoV1, error) {
+
+	if err := checksum.verify(correlationId, blob); err != nil {
+		return nil, err
+	}
+	if decoder != nil {
+		if err := decoder.Close(); err != nil {
+			return nil, err
+		}
+	}
+	return blob, nil
+}
+
+// readStreamAhead returns the number of bytes passed to the stream
+func (c *TBlobsStreamProcessorV1) readStreamAhead(ctx context.Context, correlationId string, blobId string, size int64,
+	reader IBlobsChunkyReaderV1, stream io.Writer, progress *blobsProgress, options *BlobsTransferOptionsV1) (int64, error) {
+
+	prefetcher := newBlobsChunkPrefetcher(ctx, correlationId, reader, blobId, size, options)
+	defer prefetcher.close()
+
+	skip := int64(0)
+	for {
+		buffer, err := prefetcher.next()
+		if err == io.EOF {
 			break
 		}
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return skip, wrapBlobsContextError(ctx, correlationId, blobId, skip, err)
+		}
+
+		_, err = stream.Write(buffer)
+		if err != nil {
+			return skip, err
+		}
+		skip += int64(len(buffer))
+		progress.add(len(buffer))
 	}
 
-	// Close blob read
+	return skip, nil
+}
+
+// OpenBlobReader starts reading the blob and returns a reader with random access to its content.
+// Compressed content has no random access, so encoded blobs are opened only for raw content.
+// The reader must be closed to end reading.
+func (c *TBlobsStreamProcessorV1) OpenBlobReader(ctx context.Context, correlationId string, blobId string,
+	reader IBlobsChunkyReaderV1, options *BlobsTransferOptionsV1) (*BlobsReaderV1, error) {
+
+	blob, err := reader.BeginBlobRead(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+	}
+	if blob.ContentEncoding != ContentEncodingNone && !options.isRawContent() {
+		endBlobRead(correlationId, reader, blobId)
+		return nil, cerr.NewBadRequestError(correlationId, "UNSUPPORTED_ENCODING",
+			"Blob "+blobId+" with content encoding "+blob.ContentEncoding+" can not be read with random access").
+			WithDetails("blob_id", blobId).WithDetails("encoding", blob.ContentEncoding)
+	}
+
+	return newBlobsReader(ctx, correlationId, reader, blob, options), nil
+}
+
+// OpenBlobWriter starts writing the blob and returns a writer for its content.
+// The writer must be closed to finish the blob or closed with error to abort it,
+// an unclosed writer is released only when the context is cancelled.
+func (c *TBlobsStreamProcessorV1) OpenBlobWriter(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	writer IBlobsChunkyWriterV1, options *BlobsTransferOptionsV1) (*BlobsWriterV1, error) {
+
+	// Generate blob id
+	if blob.Id == "" {
+		blob.Id = data.IdGenerator.NextLong()
+	}
+	blob.CreateTime = time.Now()
+
+	// Content is not available yet, so the type is taken only from the name
+	detectBlobContentType(blob, nil)
+	err := checkBlobContentType(correlationId, blob, options)
+	if err != nil {
+		return nil, err
+	}
+
+	codec, err := prepareBlobEncoding(correlationId, blob, options)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := options.newChecksum()
+	checksum.prepare(blob)
+
+	token, err := writer.BeginBlobWrite(ctx, correlationId, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlobsWriter(ctx, correlationId, writer, token, checksum, codec, options)
+}
+
+// GetBlobRangeStreamByIdWithOptions writes length bytes of the blob starting from offset to the stream.
+// Negative length reads the blob till the end.
+func (c *TBlobsStreamProcessorV1) GetBlobRangeStreamByIdWithOptions(ctx context.Context, correlationId string,
+	blobId string, offset int64, length int64, reader IBlobsChunkyReaderV1, stream io.Writer,
+	options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	chunkSize := int64(options.getChunkSize())
+
+	blob, err := reader.BeginBlobRead(ctx, correlationId, blobId)
+	if err != nil {
+		return nil, err
+	}
+	if blob == nil {
+		return nil, cerr.NewNotFoundError(correlationId, "BLOB_NOT_FOUND",
+			"Blob "+blobId+" was not found").WithDetails("blob_id", blobId)
+	}
+
+	// Compressed content has no random access, so the range is cut from decompressed stream
+	if codec, _ := getBlobDecoding(correlationId, blob, options); codec != nil {
+		endBlobRead(correlationId, reader, blobId)
+		return c.getEncodedBlobRangeStream(ctx, correlationId, blobId, offset, length, reader, stream, options)
+	}
+
+	// Fit the range into the blob
+	if offset < 0 || offset > blob.Size {
+		endBlobRead(correlationId, reader, blobId)
+		return nil, cerr.NewBadRequestError(correlationId, "INVALID_RANGE",
+			"Range offset "+strconv.FormatInt(offset, 10)+" is outside of blob "+blobId).
+			WithDetails("blob_id", blobId).WithDetails("offset", offset).WithDetails("size", blob.Size)
+	}
+	if length < 0 || offset+length > blob.Size {
+		length = blob.Size - offset
+	}
+
+	// Release the read on the server when the transfer fails
+	ended := false
+	defer func() {
+		if !ended {
+			endBlobRead(correlationId, reader, blobId)
+		}
+	}()
+
+	// Read in chunks
+	sizer := newBlobsChunkSizer(options)
+	skip := offset
+	end := offset + length
+	for skip < end {
+		take := int64(sizer.next(int(chunkSize)))
+		if take > end-skip {
+			take = end - skip
+		}
+
+		// Stop between chunks when the transfer is cancelled
+		err := ctx.Err()
+		if err == nil {
+			err = options.getDownloadLimiter().Wait(ctx, int(take))
+		}
+		var buffer []byte
+		if err == nil {
+			buffer, err = options.getRetries().readChunk(ctx, correlationId, reader, blobId, skip, take, sizer)
+		}
+		if err != nil {
+			return nil, wrapBlobsContextError(ctx, correlationId, blobId, skip, err)
+		}
+
+		// Content shorter than the blob size must not pass for the whole range
+		if len(buffer) == 0 {
+			return nil, NewBlobsTruncatedErrorV1(correlationId, blobId, end, skip)
+		}
+		// Bytes beyond the requested range are not passed to the stream
+		if int64(len(buffer)) > take {
+			buffer = buffer[:take]
+		}
+
+		n, err := stream.Write(buffer)
+		if err == nil && n < len(buffer) {
+			err = io.ErrShortWrite
+		}
+		if err != nil {
+			return nil, err
+		}
+		skip += int64(n)
+	}
+
+	ended = true
 	err = reader.EndBlobRead(ctx, correlationId, blobId)
 	if err != nil {
 		return nil, err
@@ -113,3 +657,64 @@ func (c *TBlobsStreamProcessorV1) GetBlobStreamById(ctx context.Context, correla
 
 	return blob, nil
 }
+
+func (c *TBlobsStreamProcessorV1) getEncodedBlobRangeStream(ctx context.Context, correlationId string,
+	blobId string, offset int64, length int64, reader IBlobsChunkyReaderV1, stream io.Writer,
+	options *BlobsTransferOptionsV1) (*BlobInfoV1, error) {
+
+	newRangeError := func(size int64) error {
+		return cerr.NewBadRequestError(correlationId, "INVALID_RANGE",
+			"Range offset "+strconv.FormatInt(offset, 10)+" is outside of blob "+blobId).
+			WithDetails("blob_id", blobId).WithDetails("offset", offset).WithDetails("size", size)
+	}
+	if offset < 0 {
+		return nil, newRangeError(-1)
+	}
+
+	writer := &blobsRangeWriter{stream: stream, skip: offset, take: length}
+	blob, err := c.GetBlobStreamByIdWithOptions(ctx, correlationId, blobId, reader, writer, options)
+	if err != nil {
+		return nil, err
+	}
+
+	// Size of decompressed content is known only at the end
+	if offset > writer.size {
+		return nil, newRangeError(writer.size)
+	}
+
+	return blob, nil
+}
+
+// blobsRangeWriter passes to the stream only the requested range of the content
+type blobsRangeWriter struct {
+	stream io.Writer
+	skip   int64
+	take   int64
+	size   int64
+}
+
+func (c *blobsRangeWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	start := c.skip - c.size
+	c.size += int64(n)
+
+	if start < 0 {
+		start = 0
+	}
+	if start >= int64(n) || c.take == 0 {
+		return n, nil
+	}
+
+	chunk := p[start:]
+	if c.take > 0 && int64(len(chunk)) > c.take {
+		chunk = chunk[:c.take]
+	}
+	if c.take > 0 {
+		c.take -= int64(len(chunk))
+	}
+
+	if _, err := c.stream.Write(chunk); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
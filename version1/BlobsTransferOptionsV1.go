@@ -0,0 +1,251 @@
+package version1
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+)
+
+// BlobsTransferOptionsV1 holds the settings used by the chunk processors
+// to move blob content between a client and the blobs service.
+//
+//	Configuration parameters:
+//		- options:
+//			- chunk_size: size of a single chunk in bytes (default: 10240)
+//			- adaptive_chunks: true to adapt chunk size of sequential transfers to the connection (default: false)
+//			- min_chunk_size: initial and minimum size of adaptive chunks in bytes (default: 4096)
+//			- max_chunk_size: maximum size of adaptive chunks in bytes (default: 1048576)
+//			- chunk_rtt: target round-trip time of a chunk in milliseconds (default: 500)
+//			- max_message_size: maximum message size accepted by the server, chunks are kept within it (default: 4194304)
+//			- upload_concurrency: number of chunks uploaded in parallel (default: 1)
+//			- read_ahead: number of chunks downloaded ahead of the consumer (default: 1)
+//			- checkpoint_path: folder to keep checkpoints of resumable uploads (default: kept in memory)
+//			- checksum: digest calculated for uploads and verified on reads: md5, sha256 or none (default: none)
+//			- content_encoding: compression of uploaded content: gzip, zstd or none (default: none)
+//			- raw_content: true to read compressed blobs as stored without decompression (default: false)
+//			- page_size: number of blobs requested at once when listing blobs (default: 100)
+//			- max_upload_rate: maximum upload rate in bytes per second, 0 for unlimited (default: 0)
+//			- max_download_rate: maximum download rate in bytes per second, 0 for unlimited (default: 0)
+//			- rate_group: name to share rate limits with other clients in the process (default: not shared)
+//			- allowed_content_types: comma-separated content types accepted for uploads, like "image/*,application/pdf" (default: any)
+//			- retries.*: retry policy for chunk operations, see BlobsRetryPolicyV1
+//			- uri.*: downloads of blobs created from uri, see BlobsUriOptionsV1
+type BlobsTransferOptionsV1 struct {
+	ChunkSize         int
+	UploadConcurrency int
+	ReadAhead         int
+	Checkpoints       IBlobsCheckpointStoreV1
+	Checksum          string
+	PageSize          int
+	ContentEncoding   string
+	RawContent        bool
+	Retries           *BlobsRetryPolicyV1
+	Uri               *BlobsUriOptionsV1
+
+	// Content types accepted for uploads, wildcard subtypes like "image/*" are supported
+	AllowedContentTypes []string
+	// Observer of transfers, the one attached to the context takes precedence
+	Progress IBlobsProgressObserverV1
+	// Limiters of transfer rates, the same limiter may be set for several clients
+	UploadLimiter   *BlobsRateLimiterV1
+	DownloadLimiter *BlobsRateLimiterV1
+
+	// Adaptive sizing of chunks, parallel uploads and downloads ahead keep the fixed chunk size
+	AdaptiveChunks bool
+	MinChunkSize   int
+	MaxChunkSize   int
+	ChunkRtt       time.Duration
+	MaxMessageSize int
+}
+
+func NewBlobsTransferOptionsV1() *BlobsTransferOptionsV1 {
+	return &BlobsTransferOptionsV1{
+		ChunkSize:         10240,
+		UploadConcurrency: 1,
+		ReadAhead:         1,
+		Checkpoints:       NewBlobsMemoryCheckpointStoreV1(),
+		Checksum:          ChecksumNone,
+		PageSize:          100,
+		Retries:           NewBlobsRetryPolicyV1(),
+		Uri:               NewBlobsUriOptionsV1(),
+		MinChunkSize:      4096,
+		MaxChunkSize:      1048576,
+		ChunkRtt:          500 * time.Millisecond,
+		MaxMessageSize:    4194304,
+	}
+}
+
+func NewBlobsTransferOptionsV1WithChunkSize(chunkSize int) *BlobsTransferOptionsV1 {
+	c := NewBlobsTransferOptionsV1()
+	c.ChunkSize = chunkSize
+	return c
+}
+
+func (c *BlobsTransferOptionsV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.ChunkSize = config.GetAsIntegerWithDefault("options.chunk_size", c.ChunkSize)
+	c.UploadConcurrency = config.GetAsIntegerWithDefault("options.upload_concurrency", c.UploadConcurrency)
+	c.ReadAhead = config.GetAsIntegerWithDefault("options.read_ahead", c.ReadAhead)
+	c.Checksum = config.GetAsStringWithDefault("options.checksum", c.Checksum)
+	c.PageSize = config.GetAsIntegerWithDefault("options.page_size", c.PageSize)
+	c.ContentEncoding = config.GetAsStringWithDefault("options.content_encoding", c.ContentEncoding)
+	c.RawContent = config.GetAsBooleanWithDefault("options.raw_content", c.RawContent)
+	c.AdaptiveChunks = config.GetAsBooleanWithDefault("options.adaptive_chunks", c.AdaptiveChunks)
+	c.MinChunkSize = config.GetAsIntegerWithDefault("options.min_chunk_size", c.MinChunkSize)
+	c.MaxChunkSize = config.GetAsIntegerWithDefault("options.max_chunk_size", c.MaxChunkSize)
+	c.ChunkRtt = time.Duration(config.GetAsLongWithDefault("options.chunk_rtt",
+		c.ChunkRtt.Milliseconds())) * time.Millisecond
+	c.MaxMessageSize = config.GetAsIntegerWithDefault("options.max_message_size", c.MaxMessageSize)
+
+	allowedContentTypes := config.GetAsString("options.allowed_content_types")
+	if allowedContentTypes != "" {
+		c.AllowedContentTypes = []string{}
+		for _, contentType := range strings.Split(allowedContentTypes, ",") {
+			if contentType = strings.TrimSpace(contentType); contentType != "" {
+				c.AllowedContentTypes = append(c.AllowedContentTypes, contentType)
+			}
+		}
+	}
+
+	rateGroup := config.GetAsString("options.rate_group")
+	if rate := config.GetAsLong("options.max_upload_rate"); rate > 0 {
+		c.UploadLimiter = newBlobsRateLimiter(rateGroup, "upload", rate)
+	}
+	if rate := config.GetAsLong("options.max_download_rate"); rate > 0 {
+		c.DownloadLimiter = newBlobsRateLimiter(rateGroup, "download", rate)
+	}
+
+	if c.Retries == nil {
+		c.Retries = NewBlobsRetryPolicyV1()
+	}
+	c.Retries.Configure(ctx, config)
+
+	if c.Uri == nil {
+		c.Uri = NewBlobsUriOptionsV1()
+	}
+	c.Uri.Configure(ctx, config)
+
+	checkpointPath := config.GetAsString("options.checkpoint_path")
+	if checkpointPath != "" {
+		c.Checkpoints = NewBlobsFileCheckpointStoreV1(checkpointPath)
+	}
+}
+
+func (c *BlobsTransferOptionsV1) getChunkSize() int {
+	if c == nil || c.ChunkSize <= 0 {
+		return 10240
+	}
+	return c.ChunkSize
+}
+
+// getChunkSizeRange returns bounds of adaptive chunks.
+// Chunks may be sent as base64 in JSON, so a quarter of the message is kept for encoding and the envelope.
+func (c *BlobsTransferOptionsV1) getChunkSizeRange() (int, int) {
+	minSize := 4096
+	maxSize := 1048576
+	if c != nil && c.MinChunkSize > 0 {
+		minSize = c.MinChunkSize
+	}
+	if c != nil && c.MaxChunkSize > 0 {
+		maxSize = c.MaxChunkSize
+	}
+	if c != nil && c.MaxMessageSize > 0 && maxSize > c.MaxMessageSize*3/4-1024 {
+		maxSize = c.MaxMessageSize*3/4 - 1024
+	}
+	if maxSize < 1 {
+		maxSize = 1
+	}
+	if minSize > maxSize {
+		minSize = maxSize
+	}
+	return minSize, maxSize
+}
+
+func (c *BlobsTransferOptionsV1) getChunkRtt() time.Duration {
+	if c == nil || c.ChunkRtt <= 0 {
+		return 500 * time.Millisecond
+	}
+	return c.ChunkRtt
+}
+
+func (c *BlobsTransferOptionsV1) getUploadConcurrency() int {
+	if c == nil || c.UploadConcurrency < 1 {
+		return 1
+	}
+	return c.UploadConcurrency
+}
+
+func (c *BlobsTransferOptionsV1) getReadAhead() int {
+	if c == nil || c.ReadAhead < 1 {
+		return 1
+	}
+	return c.ReadAhead
+}
+
+func (c *BlobsTransferOptionsV1) getPageSize() int {
+	if c == nil || c.PageSize < 1 {
+		return 100
+	}
+	return c.PageSize
+}
+
+func (c *BlobsTransferOptionsV1) getContentEncoding() string {
+	if c == nil || strings.EqualFold(c.ContentEncoding, "none") {
+		return ContentEncodingNone
+	}
+	return c.ContentEncoding
+}
+
+func (c *BlobsTransferOptionsV1) getAllowedContentTypes() []string {
+	if c == nil {
+		return nil
+	}
+	return c.AllowedContentTypes
+}
+
+func (c *BlobsTransferOptionsV1) isRawContent() bool {
+	return c != nil && c.RawContent
+}
+
+func (c *BlobsTransferOptionsV1) newChecksum() *blobsChecksum {
+	if c == nil {
+		return nil
+	}
+	return newBlobsChecksum(c.Checksum)
+}
+
+func (c *BlobsTransferOptionsV1) getRetries() *BlobsRetryPolicyV1 {
+	if c == nil {
+		return nil
+	}
+	return c.Retries
+}
+
+func newBlobsRateLimiter(group string, direction string, rate int64) *BlobsRateLimiterV1 {
+	if group != "" {
+		return GetSharedBlobsRateLimiterV1(group+"."+direction, rate)
+	}
+	return NewBlobsRateLimiterV1(rate)
+}
+
+func (c *BlobsTransferOptionsV1) getUploadLimiter() *BlobsRateLimiterV1 {
+	if c == nil {
+		return nil
+	}
+	return c.UploadLimiter
+}
+
+func (c *BlobsTransferOptionsV1) getDownloadLimiter() *BlobsRateLimiterV1 {
+	if c == nil {
+		return nil
+	}
+	return c.DownloadLimiter
+}
+
+func (c *BlobsTransferOptionsV1) getUri() *BlobsUriOptionsV1 {
+	if c == nil {
+		return nil
+	}
+	return c.Uri
+}
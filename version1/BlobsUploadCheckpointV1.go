@@ -0,0 +1,12 @@
+package version1
+
+import "time"
+
+// BlobsUploadCheckpointV1 records the progress of a resumable upload
+type BlobsUploadCheckpointV1 struct {
+	Key        string    `json:"key"`
+	BlobId     string    `json:"blob_id"`
+	Token      string    `json:"token"`
+	Offset     int64     `json:"offset"`
+	UpdateTime time.Time `json:"update_time"`
+}
@@ -0,0 +1,85 @@
+package version1
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	cconf "github.com/pip-services3-gox/pip-services3-commons-gox/config"
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
+)
+
+// BlobsUriOptionsV1 defines how content is downloaded when blobs are created from uri.
+//
+//	Configuration parameters:
+//		- options:
+//			- uri:
+//				- connect_timeout: timeout to connect to the server in milliseconds (default: 10000)
+//				- timeout: timeout to receive response headers in milliseconds (default: 30000)
+//				- max_redirects: maximum number of followed redirects, 0 to disable redirects (default: 10)
+//				- max_size: maximum size of downloaded content in bytes, 0 for unlimited (default: 0)
+type BlobsUriOptionsV1 struct {
+	ConnectTimeout time.Duration
+	Timeout        time.Duration
+	MaxRedirects   int
+	MaxSize        int64
+
+	// Client used for downloads instead of the one built from the timeouts and redirects settings
+	HttpClient *http.Client
+}
+
+func NewBlobsUriOptionsV1() *BlobsUriOptionsV1 {
+	return &BlobsUriOptionsV1{
+		ConnectTimeout: 10000 * time.Millisecond,
+		Timeout:        30000 * time.Millisecond,
+		MaxRedirects:   10,
+	}
+}
+
+func (c *BlobsUriOptionsV1) Configure(ctx context.Context, config *cconf.ConfigParams) {
+	c.ConnectTimeout = time.Duration(config.GetAsLongWithDefault("options.uri.connect_timeout",
+		c.ConnectTimeout.Milliseconds())) * time.Millisecond
+	c.Timeout = time.Duration(config.GetAsLongWithDefault("options.uri.timeout",
+		c.Timeout.Milliseconds())) * time.Millisecond
+	c.MaxRedirects = config.GetAsIntegerWithDefault("options.uri.max_redirects", c.MaxRedirects)
+	c.MaxSize = config.GetAsLongWithDefault("options.uri.max_size", c.MaxSize)
+}
+
+func (c *BlobsUriOptionsV1) getMaxSize() int64 {
+	if c == nil || c.MaxSize < 0 {
+		return 0
+	}
+	return c.MaxSize
+}
+
+// newHttpClient returns the client for a single download
+func (c *BlobsUriOptionsV1) newHttpClient(correlationId string) *http.Client {
+	if c == nil {
+		c = NewBlobsUriOptionsV1()
+	}
+	if c.HttpClient != nil {
+		return c.HttpClient
+	}
+
+	maxRedirects := c.MaxRedirects
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           (&net.Dialer{Timeout: c.ConnectTimeout}).DialContext,
+			TLSHandshakeTimeout:   c.ConnectTimeout,
+			ResponseHeaderTimeout: c.Timeout,
+			// Only one request is sent, so connections are not kept
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) > maxRedirects {
+				return cerr.NewBadRequestError(correlationId, "TOO_MANY_REDIRECTS",
+					"Uri was redirected more than "+strconv.Itoa(maxRedirects)+" times").
+					WithDetails("uri", via[0].URL.String())
+			}
+			return nil
+		},
+	}
+}
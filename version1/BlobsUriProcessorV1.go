@@ -2,7 +2,16 @@ package version1
 
 import (
 	"context"
+	"errors"
+	"io"
+	"mime"
 	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	cerr "github.com/pip-services3-gox/pip-services3-commons-gox/errors"
 )
 
 type TBlobsUriProcessorV1 struct{}
@@ -11,11 +20,136 @@ var BlobsUriProcessorV1 = &TBlobsUriProcessorV1{}
 
 func (c *TBlobsUriProcessorV1) CreateBlobFromUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
 	writer IBlobsChunkyWriterV1, uri string, chunkSize int) (result *BlobInfoV1, err error) {
+	return c.CreateBlobFromUriWithOptions(ctx, correlationId, blob, writer, uri,
+		NewBlobsTransferOptionsV1WithChunkSize(chunkSize))
+}
+
+// CreateBlobFromUriWithOptions downloads content from the uri and uploads it as the blob.
+// Name, content type and size of the blob are taken from the response when they are not set.
+func (c *TBlobsUriProcessorV1) CreateBlobFromUriWithOptions(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	writer IBlobsChunkyWriterV1, uri string, options *BlobsTransferOptionsV1) (result *BlobInfoV1, err error) {
 
-	resp, err := http.Get(uri)
+	stream, err := c.openUri(ctx, correlationId, blob, uri, options)
 	if err != nil {
 		return nil, err
 	}
+	defer stream.Close()
+
+	return BlobsStreamProcessorV1.CreateBlobFromStreamWithOptions(ctx, correlationId, blob, writer, stream, options)
+}
+
+// openUri starts downloading content from the uri, the result must be closed.
+// Responses other than 2xx are rejected, so error pages are never stored as blobs.
+func (c *TBlobsUriProcessorV1) openUri(ctx context.Context, correlationId string, blob *BlobInfoV1,
+	uri string, options *BlobsTransferOptionsV1) (io.ReadCloser, error) {
+
+	parsedUri, err := url.Parse(uri)
+	if err != nil || (parsedUri.Scheme != "http" && parsedUri.Scheme != "https") {
+		return nil, cerr.NewBadRequestError(correlationId, "INVALID_URI",
+			"Uri "+uri+" is not a valid http address").WithDetails("uri", uri)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := options.getUri().newHttpClient(correlationId).Do(req)
+	if err != nil {
+		// Errors of the redirect policy come wrapped
+		var appErr *cerr.ApplicationError
+		if errors.As(err, &appErr) {
+			return nil, appErr
+		}
+		return nil, cerr.NewConnectionError(correlationId, "URI_DOWNLOAD_FAILED",
+			"Failed to download "+uri).WithCause(err).WithDetails("uri", uri)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		resp.Body.Close()
+		return nil, c.newStatusError(correlationId, uri, resp.StatusCode)
+	}
+
+	maxSize := options.getUri().getMaxSize()
+	if maxSize > 0 && resp.ContentLength > maxSize {
+		resp.Body.Close()
+		return nil, newUriTooLargeError(correlationId, uri, maxSize)
+	}
+
+	if blob.Name == "" {
+		blob.Name = getUriBlobName(resp)
+	}
+
+	// Generic type does not say anything, so the content is sniffed instead
+	contentType := resp.Header.Get("Content-Type")
+	if blob.ContentType == "" && contentType != "" && !strings.HasPrefix(contentType, "application/octet-stream") {
+		blob.ContentType = contentType
+	}
+
+	// Compressed content has a different size
+	if blob.Size == 0 && resp.ContentLength > 0 && blob.ContentEncoding == ContentEncodingNone &&
+		options.getContentEncoding() == ContentEncodingNone {
+		blob.Size = resp.ContentLength
+	}
+
+	return &blobsUriReader{
+		body:          resp.Body,
+		correlationId: correlationId,
+		uri:           uri,
+		maxSize:       maxSize,
+	}, nil
+}
+
+func (c *TBlobsUriProcessorV1) newStatusError(correlationId string, uri string, status int) error {
+	message := "Failed to download " + uri + ": " + strconv.Itoa(status) + " " + http.StatusText(status)
+	if status == http.StatusNotFound || status == http.StatusGone {
+		return cerr.NewNotFoundError(correlationId, "URI_NOT_FOUND", message).
+			WithDetails("uri", uri).WithDetails("status", status)
+	}
+	return cerr.NewInvocationError(correlationId, "URI_DOWNLOAD_FAILED", message).
+		WithDetails("uri", uri).WithDetails("status", status)
+}
+
+func newUriTooLargeError(correlationId string, uri string, maxSize int64) error {
+	return cerr.NewBadRequestError(correlationId, "BLOB_TOO_LARGE",
+		"Content of "+uri+" exceeds allowed maximum size of "+strconv.FormatInt(maxSize, 10)).
+		WithDetails("uri", uri).WithDetails("max_size", maxSize)
+}
+
+// getUriBlobName takes the name from Content-Disposition header or from the last segment of the uri path
+func getUriBlobName(resp *http.Response) string {
+	if disposition := resp.Header.Get("Content-Disposition"); disposition != "" {
+		if _, params, err := mime.ParseMediaType(disposition); err == nil && params["filename"] != "" {
+			return path.Base(params["filename"])
+		}
+	}
+
+	// Location after redirects
+	name := path.Base(resp.Request.URL.Path)
+	if name == "/" || name == "." {
+		return ""
+	}
+	return name
+}
+
+// blobsUriReader stops the download when the content exceeds the allowed size
+type blobsUriReader struct {
+	body          io.ReadCloser
+	correlationId string
+	uri           string
+	maxSize       int64
+	size          int64
+}
+
+func (c *blobsUriReader) Read(p []byte) (int, error) {
+	n, err := c.body.Read(p)
+	c.size += int64(n)
+	if c.maxSize > 0 && c.size > c.maxSize {
+		return 0, newUriTooLargeError(c.correlationId, c.uri, c.maxSize)
+	}
+	return n, err
+}
 
-	return BlobsStreamProcessorV1.CreateBlobFromStream(ctx, correlationId, blob, writer, resp.Body, chunkSize)
+func (c *blobsUriReader) Close() error {
+	return c.body.Close()
 }
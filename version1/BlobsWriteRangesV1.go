@@ -0,0 +1,38 @@
+package version1
+
+// blobsWriteRanges tracks content written by parts that may come in any order.
+// Committed is the end of the contiguous prefix from offset 0, parts past it
+// wait in Pending by their offsets until the gap before them is filled.
+type blobsWriteRanges struct {
+	Committed int64           `json:"committed"`
+	Pending   map[int64]int64 `json:"pending,omitempty"`
+}
+
+// add records the part from offset to end and extends the committed prefix with the pending parts it reaches
+func (c *blobsWriteRanges) add(offset int64, end int64) {
+	if offset > c.Committed {
+		if c.Pending == nil {
+			c.Pending = make(map[int64]int64)
+		}
+		if end > c.Pending[offset] {
+			c.Pending[offset] = end
+		}
+		return
+	}
+
+	if end > c.Committed {
+		c.Committed = end
+	}
+	for merged := true; merged; {
+		merged = false
+		for start, partEnd := range c.Pending {
+			if start <= c.Committed {
+				if partEnd > c.Committed {
+					c.Committed = partEnd
+				}
+				delete(c.Pending, start)
+				merged = true
+			}
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package version1
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+)
+
+// BlobsWriterV1 creates a blob from content written by a producer through IBlobsChunkyWriterV1.
+// Content is compressed when encoding is configured, buffered and sent in chunks.
+// Close finishes the blob and CloseWithError or cancellation of the context aborts it.
+// The writer must be closed one of these ways, until then it keeps a goroutine
+// that watches the context and the write stays open on the server.
+type BlobsWriterV1 struct {
+	ctx           context.Context
+	correlationId string
+	writer        IBlobsChunkyWriterV1
+	retries       *BlobsRetryPolicyV1
+	limiter       *BlobsRateLimiterV1
+	checksum      *blobsChecksum
+	encoder       io.WriteCloser
+	token         string
+	chunkSize     int
+	buffer        []byte
+	offset        int64
+	blob          *BlobInfoV1
+	lock          sync.Mutex
+	done          chan struct{}
+	closed        bool
+	err           error
+}
+
+func newBlobsWriter(ctx context.Context, correlationId string, writer IBlobsChunkyWriterV1,
+	token string, checksum *blobsChecksum, codec *BlobsContentCodecV1, options *BlobsTransferOptionsV1) (*BlobsWriterV1, error) {
+
+	c := &BlobsWriterV1{
+		ctx:           ctx,
+		correlationId: correlationId,
+		writer:        writer,
+		retries:       options.getRetries(),
+		limiter:       options.getUploadLimiter(),
+		checksum:      checksum,
+		token:         token,
+		chunkSize:     options.getChunkSize(),
+		buffer:        make([]byte, 0, options.getChunkSize()),
+		done:          make(chan struct{}),
+	}
+
+	// Compressed content goes to the chunks through the encoder
+	if codec != nil {
+		encoder, err := codec.NewWriter(blobsWriterSink{c})
+		if err != nil {
+			abortBlobWrite(correlationId, writer, token)
+			return nil, err
+		}
+		c.encoder = encoder
+	}
+
+	// Abort the blob as soon as the context is cancelled.
+	// The goroutine ends when the writer is closed.
+	go func() {
+		select {
+		case <-ctx.Done():
+			c.lock.Lock()
+			defer c.lock.Unlock()
+			c.abort(ctx.Err())
+		case <-c.done:
+		}
+	}()
+
+	return c, nil
+}
+
+// Blob returns information about the created blob after the writer was closed
+func (c *BlobsWriterV1) Blob() *BlobInfoV1 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.blob
+}
+
+func (c *BlobsWriterV1) Write(p []byte) (int, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		if c.err != nil {
+			return 0, c.err
+		}
+		return 0, os.ErrClosed
+	}
+	if err := c.ctx.Err(); err != nil {
+		c.abort(err)
+		return 0, err
+	}
+
+	if c.encoder != nil {
+		n, err := c.encoder.Write(p)
+		if err != nil {
+			c.abort(err)
+		}
+		return n, err
+	}
+	return c.write(p)
+}
+
+// write sends full chunks and keeps the rest in the buffer, it must be called under the lock
+func (c *BlobsWriterV1) write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		size := c.chunkSize - len(c.buffer)
+		if size > len(p) {
+			size = len(p)
+		}
+		c.buffer = append(c.buffer, p[:size]...)
+		p = p[size:]
+
+		if len(c.buffer) < c.chunkSize {
+			break
+		}
+
+		var token string
+		err := c.limiter.Wait(c.ctx, len(c.buffer))
+		if err == nil {
+			token, err = c.retries.writeChunk(c.ctx, c.correlationId, c.writer, c.token, c.offset, c.buffer, nil)
+		}
+		if err != nil {
+			// Bytes left in the buffer were not sent
+			written := n - len(p) - len(c.buffer)
+			if written < 0 {
+				written = 0
+			}
+			c.abort(err)
+			return written, err
+		}
+		c.checksum.Write(c.buffer)
+		c.token = token
+		c.offset += int64(len(c.buffer))
+		c.buffer = c.buffer[:0]
+	}
+
+	return n, nil
+}
+
+// Close sends the rest of the content and finishes the blob.
+// Information about the created blob is available through Blob.
+func (c *BlobsWriterV1) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return c.err
+	}
+	if err := c.ctx.Err(); err != nil {
+		c.abort(err)
+		return err
+	}
+
+	// Flush compressed content
+	if c.encoder != nil {
+		if err := c.encoder.Close(); err != nil {
+			c.abort(err)
+			return err
+		}
+	}
+
+	c.closed = true
+	close(c.done)
+
+	err := c.limiter.Wait(c.ctx, len(c.buffer))
+	var blob *BlobInfoV1
+	if err == nil {
+		blob, err = c.writer.EndBlobWrite(c.ctx, c.correlationId, c.token, c.buffer)
+	}
+	if err != nil {
+		c.err = err
+		abortBlobWrite(c.correlationId, c.writer, c.token)
+		return err
+	}
+	c.checksum.Write(c.buffer)
+	c.buffer = nil
+
+	blob, err = c.checksum.complete(c.ctx, c.correlationId, c.writer, blob)
+	if err != nil {
+		c.err = err
+		return err
+	}
+
+	c.blob = blob
+	return nil
+}
+
+// CloseWithError aborts the blob, so subsequent writes return err
+func (c *BlobsWriterV1) CloseWithError(err error) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.closed {
+		return nil
+	}
+	if err == nil {
+		err = os.ErrClosed
+	}
+	return c.abort(err)
+}
+
+// abort cancels the blob write, it must be called under the lock
+func (c *BlobsWriterV1) abort(err error) error {
+	if c.closed {
+		return nil
+	}
+
+	c.closed = true
+	c.err = err
+	c.buffer = nil
+	close(c.done)
+
+	// The writer context may be already cancelled
+	return abortBlobWrite(c.correlationId, c.writer, c.token)
+}
+
+// blobsWriterSink receives content from the encoder
+type blobsWriterSink struct {
+	writer *BlobsWriterV1
+}
+
+func (c blobsWriterSink) Write(p []byte) (int, error) {
+	return c.writer.write(p)
+}
@@ -0,0 +1,12 @@
+package version1
+
+import "context"
+
+// IBlobsCheckpointStoreV1 persists checkpoints of resumable uploads by a caller-defined key
+type IBlobsCheckpointStoreV1 interface {
+	LoadCheckpoint(ctx context.Context, correlationId string, key string) (checkpoint *BlobsUploadCheckpointV1, err error)
+
+	SaveCheckpoint(ctx context.Context, correlationId string, checkpoint *BlobsUploadCheckpointV1) error
+
+	DeleteCheckpoint(ctx context.Context, correlationId string, key string) error
+}
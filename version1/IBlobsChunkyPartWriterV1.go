@@ -0,0 +1,13 @@
+package version1
+
+import "context"
+
+// IBlobsChunkyPartWriterV1 is implemented by writers that accept chunks tagged
+// with their offset, so they can be sent in any order and in parallel.
+// Parts are written with the token returned by BeginBlobWrite, which stays
+// the same for the whole upload, and the blob is finalized with EndBlobWrite.
+// When the server does not support parts, chunks are sent sequentially.
+type IBlobsChunkyPartWriterV1 interface {
+	SupportsBlobParts(ctx context.Context, correlationId string) bool
+	WriteBlobPart(ctx context.Context, correlationId string, token string, offset int64, chunk []byte) error
+}
@@ -0,0 +1,11 @@
+package version1
+
+import "context"
+
+// IBlobsKeyProviderV1 protects data keys of encrypted blobs with a master key it holds.
+// The key id returned by WrapKey is stored with the blob and passed back to UnwrapKey.
+type IBlobsKeyProviderV1 interface {
+	WrapKey(ctx context.Context, correlationId string, key []byte) (keyId string, wrappedKey []byte, err error)
+
+	UnwrapKey(ctx context.Context, correlationId string, keyId string, wrappedKey []byte) (key []byte, err error)
+}
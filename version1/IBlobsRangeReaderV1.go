@@ -0,0 +1,19 @@
+package version1
+
+import (
+	"context"
+	"io"
+)
+
+// IBlobsRangeReaderV1 is implemented by clients that read a part of blob content,
+// for instance to resume an interrupted download without fetching it again.
+// Length bytes are read starting from offset, negative length reads till the end,
+// and an offset outside of the blob fails with INVALID_RANGE.
+// Compressed blobs are decompressed first, so the range applies to the original content.
+type IBlobsRangeReaderV1 interface {
+	GetBlobRangeById(ctx context.Context, correlationId string, blobId string,
+		offset int64, length int64) (result []byte, blob *BlobInfoV1, err error)
+
+	ReadBlobRangeStreamById(ctx context.Context, correlationId string, blobId string,
+		offset int64, length int64, stream io.Writer) (blob *BlobInfoV1, err error)
+}
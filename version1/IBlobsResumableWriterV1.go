@@ -0,0 +1,10 @@
+package version1
+
+import "context"
+
+// IBlobsResumableWriterV1 is implemented by writers that can report how many bytes
+// of an unfinished upload were committed, so the upload can continue from there.
+// The returned token replaces the given one for the following chunks.
+type IBlobsResumableWriterV1 interface {
+	GetBlobWriteOffset(ctx context.Context, correlationId string, token string) (offset int64, token2 string, err error)
+}
@@ -0,0 +1,15 @@
+package version1
+
+import (
+	"context"
+
+	"github.com/pip-services3-gox/pip-services3-commons-gox/data"
+)
+
+// IBlobsSortedReaderV1 is implemented by clients that list blobs in the requested order.
+// Blobs are sorted by blob info fields, like name, size or create_time, before the page is taken,
+// so consecutive pages do not overlap. Without sort fields local clients order blobs by create time.
+type IBlobsSortedReaderV1 interface {
+	GetBlobsByFilterWithSort(ctx context.Context, correlationId string, filter *data.FilterParams,
+		paging *data.PagingParams, sort *data.SortParams) (result data.DataPage[*BlobInfoV1], err error)
+}